@@ -1,99 +1,246 @@
 package ga
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"log/slog"
 	"math/rand"
-	"strconv"
 	"time"
 )
 
-func check(e error) {
-	if e != nil {
-		panic(e)
-	}
-}
-
-type GeneticAlgorithm struct {
-	Candidates    Population
-	BestCandidate Genome
+// GeneticAlgorithmOf evolves a population of genomes with genes of type T
+type GeneticAlgorithmOf[T comparable] struct {
+	Candidates    PopulationOf[T]
+	BestCandidate GenomeOf[T]
 	Generations   int
+	Config        RunConfig
+	History       []GenerationStatsOf[T]
+	HistoryWriter HistoryWriterOf[T]
+	Checkpoints   CheckpointConfig
 
 	IterationsSinceChange int
+	Evaluations           int
 
-	GenerateCandidate GenerateCandidateFunction
-	Crossover         CrossoverFunction
-	Mutate            MutateFunction
-	Fitness           FitnessFunction
-	Selection         SelectFunction
-	Output            func(a ...interface{})
+	GenerateCandidate GenerateCandidateFunctionOf[T]
+	Crossover         CrossoverFunctionOf[T]
+	Mutate            MutateFunctionOf[T]
+	MutateErr         MutateErrFunctionOf[T]
+	MutateGene        MutateGeneFunctionOf[T]
+	Fitness           FitnessFunctionOf[T]
+	FitnessErr        FitnessErrFunctionOf[T]
+	FitnessCache      *FitnessCacheOf[T]
+	Selection         SelectFunctionOf[T]
+	Objective         Objective
+	Parsimony         float64
+	Logger            Logger
+	Hooks             HooksOf[T]
 
+	// RulesMatch, EncodeRules and DecodeRules are used by the bitstring rule-induction helpers,
+	// and are only required when T is string
 	RulesMatch  RulesMatchFunc
 	EncodeRules EncodeRulesFunc
 	DecodeRules DecodeRulesFunc
 
 	RandomEngine *rand.Rand
+	TimeLimit    time.Duration
+	Workers      int
+
+	source          *Source
+	sourceEngine    *rand.Rand
+	generationState SourceState
+	started         time.Time
+	lastCheckpoint  time.Time
+	fitnessErr      error
+}
+
+// GeneticAlgorithm evolves bitstrings
+type GeneticAlgorithm = GeneticAlgorithmOf[string]
+
+// NewGeneticAlgorithmOf returns a GA with one-point crossover, tournament selection and the default logger.
+// The candidate generator, mutate and fitness functions depend on T and must be set before running it.
+// When T is string, the default rule-induction functions are also set
+func NewGeneticAlgorithmOf[T comparable]() GeneticAlgorithmOf[T] {
+	var geneticAlgorithm GeneticAlgorithmOf[T]
+	geneticAlgorithm.SetCrossoverFunc(OnePointCrossover[T])
+	geneticAlgorithm.SetSelectionFunc(Tournament[T])
+	geneticAlgorithm.SetLogger(slog.Default())
+	geneticAlgorithm.SetSeed(time.Now().Unix())
+	geneticAlgorithm.SetConfig(NewRunConfig(0, 0, 0))
+	geneticAlgorithm.setDefaultRuleFuncs()
+	return geneticAlgorithm
 }
 
 func NewGeneticAlgorithm() GeneticAlgorithm {
-	var geneticAlgorithm GeneticAlgorithm
+	geneticAlgorithm := NewGeneticAlgorithmOf[string]()
 	geneticAlgorithm.SetGenerateCandidate(DefaultGenerateCandidate)
 	geneticAlgorithm.SetCrossoverFunc(DefaultCrossoverFunc)
 	geneticAlgorithm.SetMutateFunc(DefaultMutateFunc)
+	geneticAlgorithm.SetMutateGeneFunc(DefaultMutateGeneFunc)
 	geneticAlgorithm.SetFitnessFunc(DefaultFitnessFunc)
 	geneticAlgorithm.SetSelectionFunc(TournamentSelection)
-	geneticAlgorithm.SetOutputFunc(PrintToConsole)
-	geneticAlgorithm.SetSeed(time.Now().Unix())
-
-	geneticAlgorithm.SetRulesMatchFunc(DefaultRulesMatchFunc)
-	geneticAlgorithm.SetEncodeRulesFunc(DefaultEncodeRulesFunc)
-	geneticAlgorithm.SetDecodeRulesFunc(DefaultDecodeRulesFunc)
 	return geneticAlgorithm
 }
 
-func (genA *GeneticAlgorithm) SetSeed(seed int64) {
-	genA.RandomEngine = rand.New(rand.NewSource(seed))
+// SetConfig sets the parameters used by Init and Step
+func (genA *GeneticAlgorithmOf[T]) SetConfig(config RunConfig) {
+	genA.Config = config
+}
+
+// SetSeed replaces RandomEngine with one seeded with seed, whose state is saved by SaveCheckpoint
+func (genA *GeneticAlgorithmOf[T]) SetSeed(seed int64) {
+	genA.source = NewSource(seed)
+	genA.RandomEngine = rand.New(genA.source)
+	genA.sourceEngine = genA.RandomEngine
+}
+
+// SetTimeLimit sets the wall-clock duration after which a run terminates. Zero disables the limit
+func (genA *GeneticAlgorithmOf[T]) SetTimeLimit(limit time.Duration) {
+	genA.TimeLimit = limit
 }
 
-func (genA *GeneticAlgorithm) UpdateBestCandidate(bestGeneration Genome) {
-	if genA.Fitness(bestGeneration) > genA.Fitness(genA.BestCandidate) {
-		genA.BestCandidate = bestGeneration.Copy()
+func (genA *GeneticAlgorithmOf[T]) UpdateBestCandidate(bestGeneration GenomeOf[T]) {
+	if len(genA.BestCandidate.Sequence) == 0 || genA.better(genA.evaluate(bestGeneration), genA.evaluate(genA.BestCandidate)) {
+		genA.BestCandidate = bestGeneration.duplicate()
 		genA.IterationsSinceChange = 0
+		if genA.Hooks.OnImprovement != nil {
+			genA.Hooks.OnImprovement(EventOf[T]{
+				Generation: genA.Generations,
+				Stats:      genA.Stats(genA.Candidates),
+				Best:       genA.BestCandidate.duplicate(),
+			})
+		}
 	}
 }
 
-func (genA *GeneticAlgorithm) FillRandomPopulation(populationSize, candidateLength int) Population {
-	candidatePool := make(Population, 0)
+// FillRandomPopulation returns populationSize new candidates from GenerateCandidate, or the first error it returns
+func (genA *GeneticAlgorithmOf[T]) FillRandomPopulation(populationSize, candidateLength int) (PopulationOf[T], error) {
+	candidatePool := make(PopulationOf[T], 0)
 	for len(candidatePool) < populationSize {
 		bitstring, err := genA.GenerateCandidate(candidateLength, genA.RandomEngine)
-		check(err)
-		candidatePool = append(candidatePool, Genome{bitstring})
+		if err != nil {
+			return nil, err
+		}
+		candidatePool = append(candidatePool, GenomeOf[T]{Sequence: bitstring})
 	}
-	return candidatePool
+	return candidatePool, nil
 }
 
-func (genA *GeneticAlgorithm) Summarise(title string, candidatePool Population) {
+// Summarise logs the fitness of every candidate in candidatePool at debug level.
+// Summarise never calls the fitness function: candidates not yet evaluated are shown as "?" and left out of the summary
+func (genA *GeneticAlgorithmOf[T]) Summarise(title string, candidatePool PopulationOf[T]) {
+	if !genA.debugEnabled() {
+		return
+	}
 	output := ""
-	output += title
 	output += "{"
 	for _, val := range candidatePool {
 		output += "["
 		if len(val.Sequence) <= 10 {
 			output += val.Sequence.String()
+		} else if val.Evaluated {
+			output += fmt.Sprintf("%3v", val.Fitness)
 		} else {
-			output += fmt.Sprintf("%3v", genA.Fitness(val))
+			output += "  ?"
 		}
 		output += "]"
 	}
 	output += "}"
-	output += " Max : " + strconv.Itoa(genA.MaxFitness(candidatePool))
-	output += " Average : " + strconv.Itoa(genA.AverageFitness(candidatePool))
-	output += " Best : " + genA.MaxFitnessCandidate(candidatePool).String()
-	genA.Output(output)
+	label := "max"
+	if genA.Objective == Minimise {
+		label = "min"
+	}
+	stats := genA.evaluatedStats(candidatePool)
+	genA.Logger.Debug(title,
+		"population", output,
+		label, stats.BestFitness,
+		"average", stats.Mean,
+		"best", stats.Best.String(),
+	)
+}
+
+func (genA *GeneticAlgorithmOf[T]) Run(populationSize, bitstringLength, generations int, crossover, mutate, terminateEarly bool) error {
+	return genA.RunContext(context.Background(), populationSize, bitstringLength, generations, crossover, mutate, terminateEarly)
+}
+
+// RunContext behaves like Run, but checks ctx between each phase of a generation and returns ctx.Err() once it is done.
+// A cancelled run leaves Candidates, BestCandidate and Generations as they were at the end of the last full generation
+func (genA *GeneticAlgorithmOf[T]) RunContext(ctx context.Context, populationSize, bitstringLength, generations int, crossover, mutate, terminateEarly bool) error {
+	config := NewRunConfig(populationSize, bitstringLength, generations)
+	config.Crossover = crossover
+	config.Mutate = mutate
+	config.TerminateEarly = terminateEarly
+	return genA.RunWithConfigContext(ctx, config)
+}
+
+// RunWithConfig runs the GA with the parameters in config
+func (genA *GeneticAlgorithmOf[T]) RunWithConfig(config RunConfig) error {
+	return genA.RunWithConfigContext(context.Background(), config)
+}
+
+// RunWithConfigContext behaves like RunWithConfig, with the cancellation behaviour of RunContext
+func (genA *GeneticAlgorithmOf[T]) RunWithConfigContext(ctx context.Context, config RunConfig) error {
+	if err := genA.validate(config); err != nil {
+		return err
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	genA.Config = config
+
+	// Init
+	if err := genA.Init(config.PopulationSize, config.BitstringLength); err != nil {
+		return err
+	}
+	return genA.loop(ctx)
 }
 
-func (genA *GeneticAlgorithm) Run(populationSize, bitstringLength, generations int, crossover, mutate, terminateEarly bool) error {
+// loop runs breeding cycles until Config.Generations is reached or a termination condition is met
+func (genA *GeneticAlgorithmOf[T]) loop(ctx context.Context) error {
+	config := genA.Config
+	genA.lastCheckpoint = time.Now()
+	reason := TerminationGenerations
+	for genA.Generations < config.Generations {
+		_, err := genA.StepContext(ctx)
+		if err == nil && genA.Checkpoints.enabled() && genA.checkpointDue() {
+			_, err = genA.WriteCheckpointFile(genA.Checkpoints.Dir, genA.Checkpoints.Keep)
+		}
+		if err != nil {
+			reason = TerminationError
+			if ctx.Err() != nil {
+				reason = TerminationCancelled
+			}
+			genA.Logger.Warn("run stopped", "reason", reason, "generations", genA.Generations, "error", err)
+			genA.terminate(reason, err)
+			return err
+		}
+
+		if config.TerminateEarly && float32(genA.IterationsSinceChange) > config.stagnationLimit() {
+			reason = TerminationStagnation
+			break
+		}
+		if config.MaxEvaluations > 0 && genA.Evaluations >= config.MaxEvaluations {
+			reason = TerminationEvaluations
+			break
+		}
+		if genA.TimeLimit > 0 && time.Since(genA.started) >= genA.TimeLimit {
+			reason = TerminationTimeLimit
+			break
+		}
+	}
+
+	genA.Logger.Info("best candidate found",
+		"sequence", genA.BestCandidate.Sequence,
+		"fitness", genA.BestCandidate.Fitness,
+		"generations", genA.Generations,
+		"reason", reason,
+	)
+	genA.terminate(reason, nil)
+	return nil
+}
 
+// validate checks that every function the GA needs is set and that config is valid
+func (genA *GeneticAlgorithmOf[T]) validate(config RunConfig) error {
 	if genA.GenerateCandidate == nil {
 		return errors.New("generate func candidate is nil")
 	}
@@ -109,82 +256,31 @@ func (genA *GeneticAlgorithm) Run(populationSize, bitstringLength, generations i
 	if genA.Selection == nil {
 		return errors.New("selection func is nil")
 	}
-	if genA.Output == nil {
-		return errors.New("output func is nil")
+	if genA.Logger == nil {
+		return errors.New("logger is nil")
 	}
 	if genA.RandomEngine == nil {
 		return errors.New("random generator is not initialised")
 	}
-	if genA.RulesMatch == nil {
-		return errors.New("rulesMatch func is nil")
-	}
-	if genA.EncodeRules == nil {
-		return errors.New("encodeRules func is nil")
-	}
-	if genA.DecodeRules == nil {
-		return errors.New("decodeRules func is nil")
-	}
-
-	// Init
-	genA.Candidates = make(Population, 0)
-	genA.Candidates = genA.FillRandomPopulation(populationSize, bitstringLength)
-	genA.UpdateBestCandidate(genA.MaxFitnessCandidate(genA.Candidates))
-
-	// Run breeding cycles
-	for y := 1; y <= generations; y++ {
-		var bestCandidateOfGeneration Genome
-
-		bestCandidateOfGeneration = genA.MaxFitnessCandidate(genA.Candidates)
-		genA.UpdateBestCandidate(bestCandidateOfGeneration)
-		genA.Output("Iteration", y)
-		genA.Summarise("Start Population      :", genA.Candidates)
-
-		// Tournament
-		breedingGround := make(Population, 0)
-		breedingGround = append(breedingGround, genA.Selection(genA.Fitness, genA.Candidates, genA.RandomEngine)...)
-		bestCandidateOfGeneration = genA.MaxFitnessCandidate(genA.Candidates)
-		genA.UpdateBestCandidate(bestCandidateOfGeneration)
-		genA.Summarise("Tournament Offspring  :", breedingGround)
-
-		// Crossover
-		if crossover {
-			crossoverBreedingGround := make(Population, 0)
-			for i := 0; i+1 < len(breedingGround); i += 2 {
-				newOffspring, err := genA.Crossover(breedingGround[i], breedingGround[i+1], genA.RandomEngine)
-				check(err)
-				crossoverBreedingGround = append(crossoverBreedingGround, newOffspring...)
-			}
-			breedingGround = crossoverBreedingGround
-			bestCandidateOfGeneration = genA.MaxFitnessCandidate(genA.Candidates)
-			genA.UpdateBestCandidate(bestCandidateOfGeneration)
-			genA.Summarise("Crossover Offspring   :", breedingGround)
+	if _, bitstring := any(genA).(*GeneticAlgorithm); bitstring {
+		if genA.RulesMatch == nil {
+			return errors.New("rulesMatch func is nil")
 		}
-
-		// Mutation
-		if mutate {
-			for index := range breedingGround {
-				breedingGround[index] = genA.Mutate(breedingGround[index], genA.RandomEngine)
-			}
-			bestCandidateOfGeneration = genA.MaxFitnessCandidate(genA.Candidates)
-			genA.UpdateBestCandidate(bestCandidateOfGeneration)
-			genA.Summarise("Mutation Offspring    :", breedingGround)
+		if genA.EncodeRules == nil {
+			return errors.New("encodeRules func is nil")
 		}
-
-		genA.Generations++
-		genA.IterationsSinceChange++
-		genA.Candidates = make(Population, populationSize)
-		copy(genA.Candidates, breedingGround)
-		genA.Summarise("Final Population      :", breedingGround)
-		genA.Output()
-		genA.Output()
-
-		if terminateEarly && float32(genA.IterationsSinceChange) > float32(generations)*0.25 {
-			genA.Output("Termination : Stagnating change")
-			genA.Output("Best Candidate Found:", genA.BestCandidate.Sequence, "Fitness:", genA.Fitness(genA.BestCandidate))
-			break
+		if genA.DecodeRules == nil {
+			return errors.New("decodeRules func is nil")
 		}
 	}
-
-	genA.Output("Best Candidate Found:", genA.BestCandidate.Sequence, "Fitness:", genA.Fitness(genA.BestCandidate))
+	if err := config.Validate(); err != nil {
+		return err
+	}
+	if err := genA.Checkpoints.validate(); err != nil {
+		return err
+	}
+	if config.Mutate && config.MutationProbability > 0 && genA.MutateGene == nil {
+		return errors.New("mutateGene func is nil")
+	}
 	return nil
 }
@@ -1,7 +1,9 @@
 package ga
 
 import (
-	"errors"
+	"context"
+	"math"
+	"math/rand"
 	"strconv"
 	"testing"
 	"time"
@@ -12,37 +14,6 @@ const (
 	TOURNAMENT = iota
 )
 
-func TestCheckErrors(t *testing.T) {
-	t.Parallel()
-	if t.Run("NilError", func(t *testing.T) {
-		t.Parallel()
-		check(nil)
-		defer func() {
-			if r := recover(); r == nil {
-				t.Log("Panic correctly not thrown on nil error")
-			} else {
-				t.Error("Panic was thrown for a nil error")
-			}
-		}()
-	}) &&
-		t.Run("BadError", func(t *testing.T) {
-			t.Parallel()
-			err := errors.New("horrible error")
-			defer func() {
-				if r := recover(); r != nil {
-					t.Log("Panic successfully thrown on bad error")
-				} else {
-					t.Error("Panic either not thrown, or could not recover. ")
-				}
-			}()
-			check(err)
-		}) {
-		t.Log("Check errors successfull")
-	} else {
-		t.Error("Check errors failed")
-	}
-}
-
 func TestFillRandomPopulation(t *testing.T) {
 	t.Parallel()
 	var geneticAlgorithm = NewGeneticAlgorithm()
@@ -50,8 +21,10 @@ func TestFillRandomPopulation(t *testing.T) {
 	geneticAlgorithm.SetOutputFunc(func(a ...interface{}) { t.Log(a...) })
 
 	expectedLen := 10
-	candidatePool := make(Population, 0)
-	candidatePool = geneticAlgorithm.FillRandomPopulation(expectedLen, expectedLen)
+	candidatePool, err := geneticAlgorithm.FillRandomPopulation(expectedLen, expectedLen)
+	if err != nil {
+		t.Error("Population could not be filled. Got:", err)
+	}
 	gotLen := len(candidatePool)
 
 	if gotLen != expectedLen {
@@ -250,7 +223,7 @@ func testGA(length, generations, expectedFitness, selectionMethod int, terminate
 	geneticAlgorithm.SetSeed(3)
 
 	geneticAlgorithm.Run(length, length, generations, true, true, terminateEarly)
-	geneticAlgorithm.Output(geneticAlgorithm.BestCandidate, geneticAlgorithm.Candidates)
+	geneticAlgorithm.Logger.Info("result", "best", geneticAlgorithm.BestCandidate, "candidates", geneticAlgorithm.Candidates)
 
 	gotBestCandidateLength := len(geneticAlgorithm.BestCandidate.Sequence)
 	if gotBestCandidateLength != length {
@@ -260,7 +233,7 @@ func testGA(length, generations, expectedFitness, selectionMethod int, terminate
 	}
 
 	gotFitness := geneticAlgorithm.Fitness(geneticAlgorithm.BestCandidate)
-	if gotFitness < expectedFitness {
+	if gotFitness < float64(expectedFitness) {
 		t.Error("GA did not produce a suitable candidate.", "Expected at least:", expectedFitness, "Got:", gotFitness)
 	} else {
 		t.Log("GA produced a suitable candidate.", "Expected at least:", expectedFitness, "Got:", gotFitness)
@@ -294,3 +267,195 @@ func TestGA(t *testing.T) {
 		}
 	})
 }
+
+func TestRunContextCancelled(t *testing.T) {
+	t.Parallel()
+	var geneticAlgorithm = NewGeneticAlgorithm()
+	geneticAlgorithm.SetSeed(3)
+	geneticAlgorithm.SetOutputFunc(func(a ...interface{}) { t.Log(a...) })
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := geneticAlgorithm.RunContext(ctx, 10, 10, 10, true, true, false)
+	if err != context.Canceled {
+		t.Error("GA did not return context error.", "Expected:", context.Canceled, "Got:", err)
+	} else {
+		t.Log("GA returned context error.", "Expected:", context.Canceled, "Got:", err)
+	}
+	if geneticAlgorithm.Generations != 0 || len(geneticAlgorithm.Candidates) != 0 {
+		t.Error("GA ran despite cancelled context.", "Generations:", geneticAlgorithm.Generations, "Candidates:", geneticAlgorithm.Candidates)
+	}
+}
+
+func TestRunContextCancelMidRun(t *testing.T) {
+	t.Parallel()
+	var geneticAlgorithm = NewGeneticAlgorithm()
+	geneticAlgorithm.SetSeed(3)
+	geneticAlgorithm.SetOutputFunc(func(a ...interface{}) {})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	populationSize := 10
+	generations := 100
+	calls := 0
+	geneticAlgorithm.SetFitnessFunc(func(gene Genome) float64 {
+		calls++
+		if calls == 500 {
+			cancel()
+		}
+		return DefaultFitnessFunc(gene)
+	})
+
+	err := geneticAlgorithm.RunContext(ctx, populationSize, 10, generations, true, true, false)
+	if err != context.Canceled {
+		t.Error("GA did not return context error.", "Expected:", context.Canceled, "Got:", err)
+	}
+	if geneticAlgorithm.Generations == 0 || geneticAlgorithm.Generations >= generations {
+		t.Error("GA did not stop mid-run.", "Generations:", geneticAlgorithm.Generations)
+	} else {
+		t.Log("GA stopped mid-run.", "Generations:", geneticAlgorithm.Generations)
+	}
+	if len(geneticAlgorithm.Candidates) != populationSize {
+		t.Error("Candidates left inconsistent.", "Expected:", populationSize, "Got:", len(geneticAlgorithm.Candidates))
+	}
+	for i, val := range geneticAlgorithm.Candidates {
+		if len(val.Sequence) != 10 {
+			t.Error("Candidate:", i, "left incomplete after cancellation. Got:", val)
+		}
+	}
+	if len(geneticAlgorithm.BestCandidate.Sequence) != 10 {
+		t.Error("Best candidate not set after cancellation. Got:", geneticAlgorithm.BestCandidate)
+	}
+}
+
+func TestTimeLimit(t *testing.T) {
+	t.Parallel()
+	var geneticAlgorithm = NewGeneticAlgorithm()
+	geneticAlgorithm.SetSeed(3)
+	geneticAlgorithm.SetOutputFunc(func(a ...interface{}) { t.Log(a...) })
+	geneticAlgorithm.SetTimeLimit(time.Nanosecond)
+
+	err := geneticAlgorithm.Run(10, 10, 100, true, true, true)
+	if err != nil {
+		t.Error("GA errored unexpectedly. Got:", err)
+	}
+	if geneticAlgorithm.Generations != 1 {
+		t.Error("GA did not stop at time limit. Expected: 1 generation. Got:", geneticAlgorithm.Generations)
+	} else {
+		t.Log("GA stopped at time limit. Expected: 1 generation. Got:", geneticAlgorithm.Generations)
+	}
+}
+
+func TestGAMinimise(t *testing.T) {
+	t.Parallel()
+	var geneticAlgorithm = NewGeneticAlgorithm()
+	geneticAlgorithm.SetSeed(3)
+	geneticAlgorithm.SetOutputFunc(func(a ...interface{}) {})
+	geneticAlgorithm.SetObjective(Minimise)
+
+	if err := geneticAlgorithm.Run(20, 20, 100, true, true, false); err != nil {
+		t.Error("GA errored unexpectedly. Got:", err)
+	}
+
+	expectedFitness := 2.0
+	gotFitness := geneticAlgorithm.Fitness(geneticAlgorithm.BestCandidate)
+	if gotFitness > expectedFitness {
+		t.Error("GA did not minimise fitness.", "Expected at most:", expectedFitness, "Got:", gotFitness)
+	} else {
+		t.Log("GA minimised fitness.", "Expected at most:", expectedFitness, "Got:", gotFitness)
+	}
+}
+
+func TestGeneticAlgorithmOf(t *testing.T) {
+	t.Parallel()
+	t.Run("Bool", func(t *testing.T) {
+		t.Parallel()
+		var geneticAlgorithm = NewGeneticAlgorithmOf[bool]()
+		geneticAlgorithm.SetSeed(3)
+		geneticAlgorithm.SetOutputFunc(func(a ...interface{}) {})
+		geneticAlgorithm.SetGenerateCandidate(func(length int, random *rand.Rand) (Sequence[bool], error) {
+			sequence := make(Sequence[bool], length)
+			for i := range sequence {
+				sequence[i] = random.Intn(2) == 1
+			}
+			return sequence, nil
+		})
+		geneticAlgorithm.SetMutateFunc(func(gene GenomeOf[bool], random *rand.Rand) GenomeOf[bool] {
+			gene = gene.Copy()
+			choice := random.Intn(len(gene.Sequence))
+			gene.Sequence[choice] = !gene.Sequence[choice]
+			return gene
+		})
+		geneticAlgorithm.SetMutateGeneFunc(func(gene bool, random *rand.Rand) bool { return !gene })
+		geneticAlgorithm.SetFitnessFunc(func(gene GenomeOf[bool]) float64 {
+			count := 0.0
+			for _, val := range gene.Sequence {
+				if val {
+					count++
+				}
+			}
+			return count
+		})
+		geneticAlgorithm.SetFitnessCache(NewFitnessCacheOf[bool](1000))
+
+		config := NewRunConfig(20, 20, 100)
+		config.MutationProbability = 0.05
+		if err := geneticAlgorithm.RunWithConfig(config); err != nil {
+			t.Fatal("GA errored unexpectedly. Got:", err)
+		}
+		expectedFitness := 18.0
+		if gotFitness := geneticAlgorithm.BestCandidate.Fitness; gotFitness < expectedFitness {
+			t.Error("GA did not produce a suitable candidate.", "Expected at least:", expectedFitness, "Got:", gotFitness)
+		} else {
+			t.Log("GA produced a suitable candidate.", "Expected at least:", expectedFitness, "Got:", geneticAlgorithm.BestCandidate)
+		}
+	})
+	t.Run("Struct", func(t *testing.T) {
+		t.Parallel()
+		type point struct{ X, Y int }
+		var geneticAlgorithm = NewGeneticAlgorithmOf[point]()
+		geneticAlgorithm.SetSeed(3)
+		geneticAlgorithm.SetOutputFunc(func(a ...interface{}) {})
+		geneticAlgorithm.SetObjective(Minimise)
+		geneticAlgorithm.SetGenerateCandidate(func(length int, random *rand.Rand) (Sequence[point], error) {
+			sequence := make(Sequence[point], length)
+			for i := range sequence {
+				sequence[i] = point{random.Intn(21) - 10, random.Intn(21) - 10}
+			}
+			return sequence, nil
+		})
+		geneticAlgorithm.SetMutateFunc(func(gene GenomeOf[point], random *rand.Rand) GenomeOf[point] {
+			gene = gene.Copy()
+			choice := random.Intn(len(gene.Sequence))
+			gene.Sequence[choice].X += random.Intn(3) - 1
+			gene.Sequence[choice].Y += random.Intn(3) - 1
+			return gene
+		})
+		geneticAlgorithm.SetFitnessFunc(func(gene GenomeOf[point]) float64 {
+			distance := 0.0
+			for _, val := range gene.Sequence {
+				distance += math.Abs(float64(val.X)) + math.Abs(float64(val.Y))
+			}
+			return distance
+		})
+
+		if err := geneticAlgorithm.Run(20, 4, 200, true, true, false); err != nil {
+			t.Fatal("GA errored unexpectedly. Got:", err)
+		}
+		expectedFitness := 4.0
+		if gotFitness := geneticAlgorithm.BestCandidate.Fitness; gotFitness > expectedFitness {
+			t.Error("GA did not minimise distance.", "Expected at most:", expectedFitness, "Got:", gotFitness)
+		} else {
+			t.Log("GA minimised distance.", "Expected at most:", expectedFitness, "Got:", geneticAlgorithm.BestCandidate)
+		}
+	})
+	t.Run("MissingFunctions", func(t *testing.T) {
+		t.Parallel()
+		var geneticAlgorithm = NewGeneticAlgorithmOf[int]()
+		if err := geneticAlgorithm.Run(10, 10, 10, true, true, false); err == nil {
+			t.Error("GA ran without a generator, mutate or fitness function")
+		} else {
+			t.Log("GA errored as expected. Got:", err)
+		}
+	})
+}
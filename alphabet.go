@@ -0,0 +1,221 @@
+package ga
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+)
+
+// Alphabet is the ordered set of values a single locus of a genome may take
+type Alphabet[T comparable] interface {
+	// Size returns the number of symbols in the alphabet
+	Size() int
+	// Symbol returns the symbol at index, which must be between 0 and Size()-1
+	Symbol(index int) T
+	// Index returns the position of symbol in the alphabet, or false if it is not in the alphabet
+	Index(symbol T) (int, bool)
+}
+
+// IntRange is the Alphabet of integers from Lower to Upper inclusive
+type IntRange struct {
+	Lower int
+	Upper int
+}
+
+// Size returns the number of integers in the range, or zero if Upper is below Lower
+func (r IntRange) Size() int {
+	if r.Upper < r.Lower {
+		return 0
+	}
+	return r.Upper - r.Lower + 1
+}
+
+// Symbol returns the integer at index
+func (r IntRange) Symbol(index int) int {
+	return r.Lower + index
+}
+
+// Index returns the position of symbol in the range
+func (r IntRange) Index(symbol int) (int, bool) {
+	if symbol < r.Lower || symbol > r.Upper {
+		return 0, false
+	}
+	return symbol - r.Lower, true
+}
+
+// Symbols is an Alphabet of categorical symbols, in the order creep mutation steps through them
+type Symbols[T comparable] []T
+
+// Size returns the number of symbols
+func (symbols Symbols[T]) Size() int {
+	return len(symbols)
+}
+
+// Symbol returns the symbol at index
+func (symbols Symbols[T]) Symbol(index int) T {
+	return symbols[index]
+}
+
+// Index returns the position of the first occurrence of symbol
+func (symbols Symbols[T]) Index(symbol T) (int, bool) {
+	for i, val := range symbols {
+		if val == symbol {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// BinaryAlphabet is the alphabet of bitstrings
+var BinaryAlphabet = Symbols[string]{"0", "1"}
+
+// TernaryAlphabet is the alphabet of rule conditions, where # matches either bit
+var TernaryAlphabet = Symbols[string]{"0", "1", "#"}
+
+// Alphabets holds the Alphabet of each locus of a genome
+type Alphabets[T comparable] []Alphabet[T]
+
+// UniformAlphabets returns Alphabets of the given length, with alphabet at every locus
+func UniformAlphabets[T comparable](length int, alphabet Alphabet[T]) Alphabets[T] {
+	alphabets := make(Alphabets[T], length)
+	for i := range alphabets {
+		alphabets[i] = alphabet
+	}
+	return alphabets
+}
+
+// Validate returns an error if there are no loci, or any locus has no alphabet or an empty one
+func (alphabets Alphabets[T]) Validate() error {
+	if len(alphabets) == 0 {
+		return errors.New("alphabets have no loci")
+	}
+	for i, val := range alphabets {
+		if val == nil || val.Size() <= 0 {
+			return fmt.Errorf("alphabet %v is empty", i)
+		}
+	}
+	return nil
+}
+
+// Contains reports whether every gene of sequence is in the alphabet of its locus
+func (alphabets Alphabets[T]) Contains(sequence Sequence[T]) bool {
+	return alphabets.check(sequence) == nil
+}
+
+// check returns an error if sequence does not have one gene per locus, or a gene is not in its locus' alphabet
+func (alphabets Alphabets[T]) check(sequence Sequence[T]) error {
+	if len(sequence) != len(alphabets) {
+		return fmt.Errorf("sequence has %v genes, alphabets have %v loci", len(sequence), len(alphabets))
+	}
+	for i, val := range sequence {
+		if _, ok := alphabets[i].Index(val); !ok {
+			return fmt.Errorf("gene %v at locus %v is not in its alphabet", val, i)
+		}
+	}
+	return nil
+}
+
+// AlphabetGenerateCandidate returns a generator that draws each gene uniformly from the alphabet of its locus.
+// The requested length must match the number of loci of alphabets
+func AlphabetGenerateCandidate[T comparable](alphabets Alphabets[T]) GenerateCandidateFunctionOf[T] {
+	return func(length int, random *rand.Rand) (Sequence[T], error) {
+		if err := alphabets.Validate(); err != nil {
+			return nil, err
+		}
+		if length != len(alphabets) {
+			return nil, fmt.Errorf("length %v does not match the %v loci of alphabets", length, len(alphabets))
+		}
+		sequence := make(Sequence[T], length)
+		for i, val := range alphabets {
+			sequence[i] = val.Symbol(random.Intn(val.Size()))
+		}
+		return sequence, nil
+	}
+}
+
+// AlphabetCrossover returns a uniform crossover that first checks both parents fit alphabets.
+// Loci are never moved, so children of valid parents are always valid
+func AlphabetCrossover[T comparable](alphabets Alphabets[T]) CrossoverFunctionOf[T] {
+	return func(gene, spouse GenomeOf[T], random *rand.Rand) (PopulationOf[T], error) {
+		if err := alphabets.check(gene.Sequence); err != nil {
+			return nil, err
+		}
+		if err := alphabets.check(spouse.Sequence); err != nil {
+			return nil, err
+		}
+		return UniformCrossover(gene, spouse, random)
+	}
+}
+
+// UniformAlphabetMutation returns a mutate function that replaces each gene, with the given probability or one
+// over the number of loci if it is not positive, by a different symbol drawn uniformly from its locus' alphabet
+func UniformAlphabetMutation[T comparable](alphabets Alphabets[T], probability float64) MutateErrFunctionOf[T] {
+	probability = mutationProbability(len(alphabets), probability)
+	return func(gene GenomeOf[T], random *rand.Rand) (GenomeOf[T], error) {
+		if err := alphabets.check(gene.Sequence); err != nil {
+			return GenomeOf[T]{}, err
+		}
+		gene = gene.Copy()
+		for i, val := range alphabets {
+			if val.Size() < 2 || random.Float64() >= probability {
+				continue
+			}
+			index, _ := val.Index(gene.Sequence[i])
+			choice := random.Intn(val.Size() - 1)
+			if choice >= index {
+				choice++
+			}
+			gene.Sequence[i] = val.Symbol(choice)
+		}
+		return gene, nil
+	}
+}
+
+// CreepMutation returns a mutate function that moves each gene, with the given probability or one over the number
+// of loci if it is not positive, up or down its locus' alphabet by between 1 and step places.
+// A gene at an end of its alphabet moves away from it, and no move passes an end, so every selected gene
+// changes and stays in its alphabet
+func CreepMutation[T comparable](alphabets Alphabets[T], step int, probability float64) MutateErrFunctionOf[T] {
+	probability = mutationProbability(len(alphabets), probability)
+	if step < 1 {
+		step = 1
+	}
+	return func(gene GenomeOf[T], random *rand.Rand) (GenomeOf[T], error) {
+		if err := alphabets.check(gene.Sequence); err != nil {
+			return GenomeOf[T]{}, err
+		}
+		gene = gene.Copy()
+		for i, val := range alphabets {
+			size := val.Size()
+			if size < 2 || random.Float64() >= probability {
+				continue
+			}
+			index, _ := val.Index(gene.Sequence[i])
+			up := random.Intn(2) == 0
+			if index == 0 || index == size-1 {
+				up = index == 0
+			}
+			room := index
+			if up {
+				room = size - 1 - index
+			}
+			delta := 1 + random.Intn(min(step, room))
+			if !up {
+				delta = -delta
+			}
+			gene.Sequence[i] = val.Symbol(index + delta)
+		}
+		return gene, nil
+	}
+}
+
+// NewAlphabetGeneticAlgorithm returns a GA over genomes drawn from a per-locus alphabet, using uniform
+// initialisation, uniform crossover, uniform alphabet mutation and tournament selection. The fitness function
+// must be set before running it, and runs must use a length equal to the number of loci of alphabets
+func NewAlphabetGeneticAlgorithm[T comparable](alphabets Alphabets[T]) GeneticAlgorithmOf[T] {
+	geneticAlgorithm := NewGeneticAlgorithmOf[T]()
+	geneticAlgorithm.SetGenerateCandidate(AlphabetGenerateCandidate(alphabets))
+	geneticAlgorithm.SetCrossoverFunc(AlphabetCrossover(alphabets))
+	geneticAlgorithm.SetMutateErrFunc(UniformAlphabetMutation(alphabets, 0))
+	return geneticAlgorithm
+}
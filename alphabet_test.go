@@ -0,0 +1,184 @@
+package ga
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestAlphabets_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		alphabets Alphabets[int]
+		valid     bool
+	}{
+		{Alphabets[int]{IntRange{0, 9}, Symbols[int]{3, 5}}, true},
+		{Alphabets[int]{IntRange{4, 4}}, true},
+		{Alphabets[int]{}, false},
+		{Alphabets[int]{IntRange{5, 4}}, false},
+		{Alphabets[int]{Symbols[int]{}}, false},
+		{Alphabets[int]{nil}, false},
+	}
+	for _, test := range tests {
+		if err := test.alphabets.Validate(); (err == nil) != test.valid {
+			t.Error("Incorrect validation.", "Alphabets:", test.alphabets, "Expected valid:", test.valid, "Got:", err)
+		}
+	}
+}
+
+func TestAlphabetOperatorsStayInAlphabet(t *testing.T) {
+	t.Parallel()
+	alphabets := Alphabets[int]{IntRange{-3, 3}, Symbols[int]{2, 4, 8, 16}, IntRange{0, 0}, IntRange{0, 1}, IntRange{10, 1000}}
+	random := rand.New(rand.NewSource(3))
+	generate := AlphabetGenerateCandidate(alphabets)
+	crossover := AlphabetCrossover(alphabets)
+	mutations := map[string]MutateErrFunctionOf[int]{
+		"Uniform": UniformAlphabetMutation(alphabets, 0.5),
+		"Creep":   CreepMutation(alphabets, 3, 0.5),
+	}
+	for i := 0; i < 500; i++ {
+		gene, err := generate(len(alphabets), random)
+		if err != nil {
+			t.Fatal("Generator errored unexpectedly. Got:", err)
+		}
+		spouse, _ := generate(len(alphabets), random)
+		if !alphabets.Contains(gene) {
+			t.Fatal("Generated gene outside alphabets. Got:", gene)
+		}
+		children, err := crossover(GenomeOf[int]{Sequence: gene}, GenomeOf[int]{Sequence: spouse}, random)
+		if err != nil {
+			t.Fatal("Crossover errored unexpectedly. Got:", err)
+		}
+		for _, child := range children {
+			if !alphabets.Contains(child.Sequence) {
+				t.Fatal("Crossover left alphabets.", "Parents:", gene, spouse, "Got:", child.Sequence)
+			}
+		}
+		for name, mutate := range mutations {
+			mutated, err := mutate(GenomeOf[int]{Sequence: gene}, random)
+			if err != nil {
+				t.Fatal(name, "errored unexpectedly. Got:", err)
+			}
+			if !alphabets.Contains(mutated.Sequence) {
+				t.Fatal(name, "left alphabets.", "Parent:", gene, "Got:", mutated.Sequence)
+			}
+		}
+	}
+
+	if _, err := generate(len(alphabets)+1, random); err == nil {
+		t.Error("Generated a gene of the wrong length")
+	}
+	bad := GenomeOf[int]{Sequence: Sequence[int]{0, 3, 0, 0, 10}}
+	if _, err := crossover(bad, bad, random); err == nil {
+		t.Error("Crossover accepted a gene outside alphabets")
+	}
+	for name, mutate := range mutations {
+		if _, err := mutate(bad, random); err == nil {
+			t.Error(name, "accepted a gene outside alphabets")
+		}
+	}
+}
+
+func TestUniformAlphabetMutation_Changes(t *testing.T) {
+	t.Parallel()
+	alphabets := UniformAlphabets[string](20, TernaryAlphabet)
+	random := rand.New(rand.NewSource(3))
+	gene := GenomeOf[string]{Sequence: make(Bitstring, 20)}
+	for i := range gene.Sequence {
+		gene.Sequence[i] = "#"
+	}
+	mutated, err := UniformAlphabetMutation(alphabets, 1)(gene, random)
+	if err != nil {
+		t.Fatal("Mutation errored unexpectedly. Got:", err)
+	}
+	for i, val := range mutated.Sequence {
+		if val == gene.Sequence[i] {
+			t.Error("Mutation kept a gene it should have changed.", "Locus:", i, "Got:", mutated.Sequence)
+		}
+	}
+}
+
+func TestCreepMutation_Step(t *testing.T) {
+	t.Parallel()
+	alphabets := UniformAlphabets[int](50, IntRange{0, 9})
+	random := rand.New(rand.NewSource(3))
+	gene := GenomeOf[int]{Sequence: make(Sequence[int], 50)}
+	for i := range gene.Sequence {
+		gene.Sequence[i] = i % 10
+	}
+	mutated, err := CreepMutation(alphabets, 2, 1)(gene, random)
+	if err != nil {
+		t.Fatal("Mutation errored unexpectedly. Got:", err)
+	}
+	for i, val := range mutated.Sequence {
+		if diff := val - gene.Sequence[i]; diff == 0 || diff < -2 || diff > 2 {
+			t.Error("Creep moved a gene an incorrect distance.", "Expected:", "1 or 2", "Got:", diff)
+		}
+	}
+}
+
+func TestCreepMutation_LargeStep(t *testing.T) {
+	t.Parallel()
+	alphabets := UniformAlphabets[int](300, IntRange{0, 1})
+	random := rand.New(rand.NewSource(3))
+	gene := GenomeOf[int]{Sequence: make(Sequence[int], 300)}
+	for i := range gene.Sequence {
+		gene.Sequence[i] = i % 2
+	}
+	mutated, err := CreepMutation(alphabets, 3, 1)(gene, random)
+	if err != nil {
+		t.Fatal("Mutation errored unexpectedly. Got:", err)
+	}
+	unchanged := 0
+	for i, val := range mutated.Sequence {
+		if val == gene.Sequence[i] {
+			unchanged++
+		}
+	}
+	if unchanged != 0 {
+		t.Error("Creep left selected genes unchanged.", "Expected:", 0, "Got:", unchanged)
+	} else {
+		t.Log("Creep moved every selected gene")
+	}
+}
+
+func TestRuleAlphabets(t *testing.T) {
+	t.Parallel()
+	alphabets := RuleAlphabets(2, 3, 1)
+	expected := []Alphabet[string]{TernaryAlphabet, TernaryAlphabet, TernaryAlphabet, BinaryAlphabet}
+	if len(alphabets) != 8 {
+		t.Fatal("Incorrect number of loci.", "Expected:", 8, "Got:", len(alphabets))
+	}
+	for i, val := range alphabets {
+		if val.Size() != expected[i%4].Size() {
+			t.Error("Incorrect alphabet.", "Locus:", i, "Expected:", expected[i%4], "Got:", val)
+		}
+	}
+}
+
+func TestNewAlphabetGeneticAlgorithm(t *testing.T) {
+	t.Parallel()
+	alphabets := UniformAlphabets[int](10, IntRange{0, 20})
+	var geneticAlgorithm = NewAlphabetGeneticAlgorithm(alphabets)
+	geneticAlgorithm.SetSeed(3)
+	geneticAlgorithm.SetOutputFunc(func(a ...interface{}) {})
+	geneticAlgorithm.SetFitnessFunc(func(gene GenomeOf[int]) float64 {
+		sum := 0
+		for _, val := range gene.Sequence {
+			sum += val
+		}
+		return float64(sum)
+	})
+
+	if err := geneticAlgorithm.Run(20, len(alphabets), 100, true, true, false); err != nil {
+		t.Fatal("GA errored unexpectedly. Got:", err)
+	}
+	expectedFitness := 190.0
+	if gotFitness := geneticAlgorithm.BestCandidate.Fitness; gotFitness < expectedFitness {
+		t.Error("GA did not produce a suitable candidate.", "Expected at least:", expectedFitness, "Got:", gotFitness)
+	} else {
+		t.Log("GA produced a suitable candidate.", "Expected at least:", expectedFitness, "Got:", geneticAlgorithm.BestCandidate)
+	}
+	if !alphabets.Contains(geneticAlgorithm.BestCandidate.Sequence) {
+		t.Error("Best candidate outside alphabets. Got:", geneticAlgorithm.BestCandidate)
+	}
+}
@@ -1,6 +1,7 @@
 package ga
 
 import (
+	"slices"
 	"testing"
 )
 
@@ -38,6 +39,22 @@ func BenchmarkGATournamentTerminateEarly_10(b *testing.B) { benchmarkGATournamen
 func BenchmarkGATournamentTerminateEarly_20(b *testing.B) { benchmarkGATournament(20, 200, true, b) }
 func BenchmarkGATournamentTerminateEarly_50(b *testing.B) { benchmarkGATournament(50, 500, true, b) }
 
+// The 500 bit benchmarks compare the string representation with the packed Bitset on the same problem
+func BenchmarkGATournamentFull_500(b *testing.B) { benchmarkGATournament(500, 100, false, b) }
+
+func benchmarkBitsetTournament(length, generations int, terminateEarly bool, b *testing.B) {
+	var genA = NewBitsetGeneticAlgorithm(length)
+	genA.SetSeed(seed)
+	for n := 0; n < b.N; n++ {
+		genA.Run(length, length, generations, true, true, terminateEarly)
+		b.Log("Best Candidate", genA.BestCandidate)
+		b.Log("Num Iterations:", genA.Generations)
+	}
+}
+
+func BenchmarkBitsetTournamentFull_50(b *testing.B)  { benchmarkBitsetTournament(50, 500, false, b) }
+func BenchmarkBitsetTournamentFull_500(b *testing.B) { benchmarkBitsetTournament(500, 100, false, b) }
+
 func benchmarkGARoulette(length, generations int, terminateEarly bool, b *testing.B) {
 	var genA = NewGeneticAlgorithm()
 	genA.SetSeed(seed)
@@ -63,3 +80,25 @@ func BenchmarkGARouletteFull_50(b *testing.B)           { benchmarkGARoulette(50
 func BenchmarkGARouletteTerminateEarly_10(b *testing.B) { benchmarkGARoulette(10, 100, true, b) }
 func BenchmarkGARouletteTerminateEarly_20(b *testing.B) { benchmarkGARoulette(20, 200, true, b) }
 func BenchmarkGARouletteTerminateEarly_50(b *testing.B) { benchmarkGARoulette(50, 500, true, b) }
+
+func benchmarkEvaluatePopulation(workers int, b *testing.B) {
+	var genA = NewGeneticAlgorithm()
+	genA.SetSeed(seed)
+	genA.SetWorkers(workers)
+	genA.SetFitnessFunc(func(gene Genome) float64 {
+		fitness := 0.0
+		for i := 0; i < 2000; i++ {
+			fitness += DefaultFitnessFunc(gene)
+		}
+		return fitness
+	})
+	candidatePool, _ := genA.FillRandomPopulation(100, 50)
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		// Evaluated genomes are not scored again, so each iteration scores a fresh copy
+		genA.EvaluatePopulation(slices.Clone(candidatePool))
+	}
+}
+
+func BenchmarkEvaluatePopulation_1(b *testing.B) { benchmarkEvaluatePopulation(1, b) }
+func BenchmarkEvaluatePopulation_4(b *testing.B) { benchmarkEvaluatePopulation(4, b) }
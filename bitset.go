@@ -0,0 +1,143 @@
+package ga
+
+import (
+	"errors"
+	"math"
+	"math/bits"
+	"math/rand"
+)
+
+// Bitset is a packed binary sequence, 64 bits to a word with bit i stored in word i/64 at position i%64.
+// Bits beyond the length of the sequence are always zero
+type Bitset = Sequence[uint64]
+
+// BitsetWords returns the number of words needed to hold length bits
+func BitsetWords(length int) int {
+	return (length + 63) / 64
+}
+
+// PackBitstring returns the Bitset holding the same bits as bitstring, treating any gene other than "1" as 0
+func PackBitstring(bitstring Bitstring) Bitset {
+	bitset := make(Bitset, BitsetWords(len(bitstring)))
+	for i, val := range bitstring {
+		if val == "1" {
+			bitset[i/64] |= 1 << uint(i%64)
+		}
+	}
+	return bitset
+}
+
+// UnpackBitset returns the first length bits of bitset as a Bitstring
+func UnpackBitset(bitset Bitset, length int) Bitstring {
+	bitstring := make(Bitstring, length)
+	for i := range bitstring {
+		bitstring[i] = "0"
+		if bitset[i/64]&(1<<uint(i%64)) != 0 {
+			bitstring[i] = "1"
+		}
+	}
+	return bitstring
+}
+
+// BitsetGenerateCandidate returns a random Bitset of length bits
+var BitsetGenerateCandidate GenerateCandidateFunctionOf[uint64] = func(length int, random *rand.Rand) (Bitset, error) {
+	if length <= 0 {
+		return nil, errors.New("strings cannot be zero-length")
+	}
+	bitset := make(Bitset, BitsetWords(length))
+	for i := range bitset {
+		bitset[i] = random.Uint64()
+	}
+	if tail := length % 64; tail != 0 {
+		bitset[len(bitset)-1] &= 1<<uint(tail) - 1
+	}
+	return bitset, nil
+}
+
+// BitsetOneMax scores a Bitset by the number of bits set
+var BitsetOneMax FitnessFunctionOf[uint64] = func(gene GenomeOf[uint64]) float64 {
+	count := 0
+	for _, word := range gene.Sequence {
+		count += bits.OnesCount64(word)
+	}
+	return float64(count)
+}
+
+// BitsetCrossover returns a one-point crossover of Bitsets of length bits, copying whole words either side of
+// the crossover point
+func BitsetCrossover(length int) CrossoverFunctionOf[uint64] {
+	return func(gene, spouse GenomeOf[uint64], random *rand.Rand) (PopulationOf[uint64], error) {
+		if len(gene.Sequence) != BitsetWords(length) || len(spouse.Sequence) != BitsetWords(length) {
+			return nil, errors.New("bitsets are not the expected length")
+		}
+		crossover := random.Int() % length
+		word, mask := crossover/64, uint64(1)<<uint(crossover%64)-1
+		child1 := make(Bitset, len(gene.Sequence))
+		child2 := make(Bitset, len(gene.Sequence))
+		copy(child1, gene.Sequence[:word])
+		copy(child2, spouse.Sequence[:word])
+		child1[word] = gene.Sequence[word]&mask | spouse.Sequence[word]&^mask
+		child2[word] = spouse.Sequence[word]&mask | gene.Sequence[word]&^mask
+		copy(child1[word+1:], spouse.Sequence[word+1:])
+		copy(child2[word+1:], gene.Sequence[word+1:])
+		return PopulationOf[uint64]{{Sequence: child1}, {Sequence: child2}}, nil
+	}
+}
+
+// checkBitset returns an error if gene is not a Bitset of length bits
+func checkBitset(gene GenomeOf[uint64], length int) error {
+	if len(gene.Sequence) != BitsetWords(length) {
+		return errors.New("bitset is not the expected length")
+	}
+	return nil
+}
+
+// BitsetMutate returns a mutate function flipping one random bit of a Bitset of length bits
+func BitsetMutate(length int) MutateErrFunctionOf[uint64] {
+	return func(gene GenomeOf[uint64], random *rand.Rand) (GenomeOf[uint64], error) {
+		if err := checkBitset(gene, length); err != nil {
+			return GenomeOf[uint64]{}, err
+		}
+		gene = gene.Copy()
+		choice := random.Int() % length
+		gene.Sequence[choice/64] ^= 1 << uint(choice%64)
+		return gene, nil
+	}
+}
+
+// BitsetBitFlip returns a mutate function flipping each bit of a Bitset of length bits with the given probability.
+// The gaps between flipped bits are drawn from a geometric distribution, so the cost is proportional to the
+// number of bits flipped rather than the length
+func BitsetBitFlip(length int, probability float64) MutateErrFunctionOf[uint64] {
+	return func(gene GenomeOf[uint64], random *rand.Rand) (GenomeOf[uint64], error) {
+		if err := checkBitset(gene, length); err != nil {
+			return GenomeOf[uint64]{}, err
+		}
+		if probability <= 0 {
+			return gene.duplicate(), nil
+		}
+		gene = gene.Copy()
+		for i := 0; i < length; i++ {
+			if probability < 1 {
+				skip := math.Floor(math.Log(1-random.Float64()) / math.Log(1-probability))
+				if skip >= float64(length-i) {
+					break
+				}
+				i += int(skip)
+			}
+			gene.Sequence[i/64] ^= 1 << uint(i%64)
+		}
+		return gene, nil
+	}
+}
+
+// NewBitsetGeneticAlgorithm returns a GA that solves OneMax over packed Bitsets of length bits, using one-point
+// crossover, single bit mutation and tournament selection. Runs must use the same length, or they return an error
+func NewBitsetGeneticAlgorithm(length int) GeneticAlgorithmOf[uint64] {
+	geneticAlgorithm := NewGeneticAlgorithmOf[uint64]()
+	geneticAlgorithm.SetGenerateCandidate(BitsetGenerateCandidate)
+	geneticAlgorithm.SetCrossoverFunc(BitsetCrossover(length))
+	geneticAlgorithm.SetMutateErrFunc(BitsetMutate(length))
+	geneticAlgorithm.SetFitnessFunc(BitsetOneMax)
+	return geneticAlgorithm
+}
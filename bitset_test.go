@@ -0,0 +1,148 @@
+package ga
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestPackBitstring(t *testing.T) {
+	t.Parallel()
+	bitstring := make(Bitstring, 70)
+	for i := range bitstring {
+		bitstring[i] = "0"
+		if i%3 == 0 {
+			bitstring[i] = "1"
+		}
+	}
+	bitset := PackBitstring(bitstring)
+	if len(bitset) != 2 {
+		t.Error("Incorrect number of words.", "Expected:", 2, "Got:", len(bitset))
+	}
+	if got := UnpackBitset(bitset, len(bitstring)); got.String() != bitstring.String() {
+		t.Error("Bitset did not round trip.", "Expected:", bitstring, "Got:", got)
+	} else {
+		t.Log("Bitset round tripped.", "Expected:", bitstring, "Got:", got)
+	}
+	expected := DefaultFitnessFunc(Genome{Sequence: bitstring})
+	if got := BitsetOneMax(GenomeOf[uint64]{Sequence: bitset}); got != expected {
+		t.Error("Incorrect OneMax fitness.", "Expected:", expected, "Got:", got)
+	}
+}
+
+func TestBitsetGenerateCandidate(t *testing.T) {
+	t.Parallel()
+	random := rand.New(rand.NewSource(3))
+	for _, length := range []int{1, 63, 64, 65, 500} {
+		bitset, err := BitsetGenerateCandidate(length, random)
+		if err != nil {
+			t.Fatal("Generator errored unexpectedly. Got:", err)
+		}
+		if len(bitset) != BitsetWords(length) {
+			t.Error("Incorrect number of words.", "Expected:", BitsetWords(length), "Got:", len(bitset))
+		}
+		if length%64 != 0 && bitset[len(bitset)-1]>>uint(length%64) != 0 {
+			t.Error("Bits set beyond the length of the sequence.", "Length:", length, "Got:", bitset)
+		}
+	}
+	if _, err := BitsetGenerateCandidate(0, random); err == nil {
+		t.Error("Generated a zero-length bitset")
+	}
+}
+
+func TestBitsetCrossover(t *testing.T) {
+	t.Parallel()
+	length := 130
+	random := rand.New(rand.NewSource(3))
+	ones := PackBitstring(UnpackBitset(Bitset{^uint64(0), ^uint64(0), ^uint64(0)}, length))
+	gene, spouse := GenomeOf[uint64]{Sequence: ones}, GenomeOf[uint64]{Sequence: make(Bitset, 3)}
+	crossover := BitsetCrossover(length)
+	for i := 0; i < 50; i++ {
+		children, err := crossover(gene, spouse, random)
+		if err != nil {
+			t.Fatal("Crossover errored unexpectedly. Got:", err)
+		}
+		first := UnpackBitset(children[0].Sequence, length)
+		point := int(BitsetOneMax(children[0]))
+		for index, val := range first {
+			if (index < point) != (val == "1") {
+				t.Fatal("Child is not a one-point crossover of its parents. Got:", first)
+			}
+		}
+		if total := BitsetOneMax(children[0]) + BitsetOneMax(children[1]); total != float64(length) {
+			t.Error("Children do not share their parents' bits.", "Expected:", length, "Got:", total)
+		}
+	}
+	if _, err := crossover(gene, GenomeOf[uint64]{Sequence: make(Bitset, 2)}, random); err == nil {
+		t.Error("Crossover of different length bitsets did not error")
+	}
+}
+
+func TestBitsetMutate(t *testing.T) {
+	t.Parallel()
+	length := 70
+	random := rand.New(rand.NewSource(3))
+	gene := GenomeOf[uint64]{Sequence: make(Bitset, 2)}
+	for i := 0; i < 200; i++ {
+		mutated, err := BitsetMutate(length)(gene, random)
+		if err != nil {
+			t.Fatal("Mutation errored unexpectedly. Got:", err)
+		}
+		if BitsetOneMax(mutated) != 1 || mutated.Sequence[1]>>uint(length%64) != 0 {
+			t.Fatal("Mutation did not flip a single bit within the sequence. Got:", mutated)
+		}
+	}
+	if BitsetOneMax(gene) != 0 {
+		t.Error("Mutation changed the original gene")
+	}
+
+	flipped := 0.0
+	for i := 0; i < 100; i++ {
+		mutated, _ := BitsetBitFlip(length, 0.1)(gene, random)
+		flipped += BitsetOneMax(mutated)
+	}
+	if mean := flipped / 100; mean < 5 || mean > 9 {
+		t.Error("Bit flip rate is not the mutation probability.", "Expected about:", 7, "Got:", mean)
+	} else {
+		t.Log("Bit flip rate matched the mutation probability.", "Expected about:", 7, "Got:", mean)
+	}
+	if mutated, _ := BitsetBitFlip(length, 1)(gene, random); BitsetOneMax(mutated) != float64(length) {
+		t.Error("Certain mutation did not flip every bit.", "Expected:", length, "Got:", BitsetOneMax(mutated))
+	}
+
+	short := GenomeOf[uint64]{Sequence: make(Bitset, 1)}
+	if _, err := BitsetMutate(length)(short, random); err == nil {
+		t.Error("Mutation of a bitset of the wrong length did not error")
+	}
+	if _, err := BitsetBitFlip(length, 0.1)(short, random); err == nil {
+		t.Error("Bit flip of a bitset of the wrong length did not error")
+	}
+}
+
+func TestNewBitsetGeneticAlgorithm_WrongLength(t *testing.T) {
+	t.Parallel()
+	var geneticAlgorithm = NewBitsetGeneticAlgorithm(200)
+	geneticAlgorithm.SetSeed(3)
+	geneticAlgorithm.SetOutputFunc(func(a ...interface{}) {})
+	if err := geneticAlgorithm.Run(10, 50, 5, false, true, false); err == nil {
+		t.Error("Run of a different length than the GA's bitsets did not error")
+	} else {
+		t.Log("Run of a different length errored. Got:", err)
+	}
+}
+
+func TestNewBitsetGeneticAlgorithm(t *testing.T) {
+	t.Parallel()
+	length := 200
+	var geneticAlgorithm = NewBitsetGeneticAlgorithm(length)
+	geneticAlgorithm.SetSeed(3)
+	geneticAlgorithm.SetOutputFunc(func(a ...interface{}) {})
+	if err := geneticAlgorithm.Run(50, length, 300, true, true, false); err != nil {
+		t.Fatal("GA errored unexpectedly. Got:", err)
+	}
+	expectedFitness := 170.0
+	if gotFitness := geneticAlgorithm.BestCandidate.Fitness; gotFitness < expectedFitness {
+		t.Error("GA did not produce a suitable candidate.", "Expected at least:", expectedFitness, "Got:", gotFitness)
+	} else {
+		t.Log("GA produced a suitable candidate.", "Expected at least:", expectedFitness, "Got:", gotFitness)
+	}
+}
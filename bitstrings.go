@@ -2,14 +2,16 @@ package ga
 
 import (
 	"errors"
-	"fmt"
 	"math/rand"
 	"strconv"
 )
 
-type Bitstring []string
+type Bitstring = Sequence[string]
 
-type GenerateCandidateFunction func(int, *rand.Rand) (Bitstring, error)
+type GenerateCandidateFunctionOf[T comparable] func(int, *rand.Rand) (Sequence[T], error)
+
+// GenerateCandidateFunction generates bitstring candidates
+type GenerateCandidateFunction = GenerateCandidateFunctionOf[string]
 
 // GenerateBitString returns an encoded string as set by calls SetGenerateBitString. Defaults to binary strings
 var DefaultGenerateCandidate GenerateCandidateFunction = func(length int, random *rand.Rand) (Bitstring, error) {
@@ -24,15 +26,6 @@ var DefaultGenerateCandidate GenerateCandidateFunction = func(length int, random
 }
 
 // SetGenerateBitString sets the function that generates the Bitstring candidatePool
-func (genA *GeneticAlgorithm) SetGenerateCandidate(f GenerateCandidateFunction) {
+func (genA *GeneticAlgorithmOf[T]) SetGenerateCandidate(f GenerateCandidateFunctionOf[T]) {
 	genA.GenerateCandidate = f
 }
-
-// SetGenerateBitString sets the function that generates the Bitstring candidatePool
-func (b Bitstring) String() string {
-	output := ""
-	for _, val := range b {
-		output += fmt.Sprintf("%v", val) + " "
-	}
-	return "[" + output + "]"
-}
@@ -33,7 +33,9 @@ func TestDefaultGenerateCandidate(t *testing.T) {
 	sum := 0
 	for _, i := range bitstring {
 		val, err := strconv.Atoi(string(i))
-		check(err)
+		if err != nil {
+			t.Fatal(err)
+		}
 		sum += val
 	}
 	if sum >= 20 {
@@ -0,0 +1,140 @@
+package ga
+
+import (
+	"container/list"
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"sync"
+)
+
+// FitnessCacheOf memoises fitness values by Genome sequence, evicting the least recently used entry once full
+type FitnessCacheOf[T comparable] struct {
+	capacity int
+	entries  map[string]*list.Element
+	order    *list.List
+	hits     uint64
+	misses   uint64
+	mutex    sync.Mutex
+}
+
+type cacheEntry struct {
+	key     string
+	fitness float64
+}
+
+// FitnessCache memoises the fitness of bitstrings
+type FitnessCache = FitnessCacheOf[string]
+
+// NewFitnessCache returns an empty FitnessCache holding at most capacity entries
+func NewFitnessCache(capacity int) *FitnessCache {
+	return NewFitnessCacheOf[string](capacity)
+}
+
+// NewFitnessCacheOf returns an empty FitnessCacheOf holding at most capacity entries
+func NewFitnessCacheOf[T comparable](capacity int) *FitnessCacheOf[T] {
+	return &FitnessCacheOf[T]{
+		capacity: capacity,
+		entries:  make(map[string]*list.Element),
+		order:    list.New(),
+	}
+}
+
+// SetFitnessCache places cache in front of the fitness function. A nil cache disables caching
+func (genA *GeneticAlgorithmOf[T]) SetFitnessCache(cache *FitnessCacheOf[T]) {
+	genA.FitnessCache = cache
+}
+
+// cacheKey returns a string that identifies the sequence of gene. Variable width genes are prefixed by their
+// length so that different sequences cannot share a key
+func cacheKey[T comparable](gene GenomeOf[T]) string {
+	var key []byte
+	switch sequence := any(gene.Sequence).(type) {
+	case Sequence[uint64]:
+		key = make([]byte, 0, 8*len(sequence))
+		for _, val := range sequence {
+			key = binary.LittleEndian.AppendUint64(key, val)
+		}
+	case Sequence[string]:
+		for _, val := range sequence {
+			key = appendPrefixed(key, val)
+		}
+	default:
+		for _, val := range gene.Sequence {
+			key = appendPrefixed(key, fmt.Sprintf("%#v", val))
+		}
+	}
+	return string(key)
+}
+
+func appendPrefixed(key []byte, val string) []byte {
+	key = strconv.AppendInt(key, int64(len(val)), 10)
+	key = append(key, ':')
+	return append(key, val...)
+}
+
+// Get returns the cached fitness of gene, and whether it was found
+func (cache *FitnessCacheOf[T]) Get(gene GenomeOf[T]) (float64, bool) {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	element, ok := cache.entries[cacheKey(gene)]
+	if !ok {
+		cache.misses++
+		return 0, false
+	}
+	cache.hits++
+	cache.order.MoveToFront(element)
+	return element.Value.(*cacheEntry).fitness, true
+}
+
+// Put stores the fitness of gene, evicting the least recently used entry if the cache is full
+func (cache *FitnessCacheOf[T]) Put(gene GenomeOf[T], fitness float64) {
+	if cache.capacity <= 0 {
+		return
+	}
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	key := cacheKey(gene)
+	if element, ok := cache.entries[key]; ok {
+		element.Value.(*cacheEntry).fitness = fitness
+		cache.order.MoveToFront(element)
+		return
+	}
+	if cache.order.Len() >= cache.capacity {
+		oldest := cache.order.Back()
+		cache.order.Remove(oldest)
+		delete(cache.entries, oldest.Value.(*cacheEntry).key)
+	}
+	cache.entries[key] = cache.order.PushFront(&cacheEntry{key, fitness})
+}
+
+// Clear removes every entry from the cache and resets its counters
+func (cache *FitnessCacheOf[T]) Clear() {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	cache.entries = make(map[string]*list.Element)
+	cache.order.Init()
+	cache.hits = 0
+	cache.misses = 0
+}
+
+// Len returns the number of entries in the cache
+func (cache *FitnessCacheOf[T]) Len() int {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	return cache.order.Len()
+}
+
+// Hits returns the number of lookups answered from the cache
+func (cache *FitnessCacheOf[T]) Hits() uint64 {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	return cache.hits
+}
+
+// Misses returns the number of lookups that had to call the fitness function
+func (cache *FitnessCacheOf[T]) Misses() uint64 {
+	cache.mutex.Lock()
+	defer cache.mutex.Unlock()
+	return cache.misses
+}
@@ -0,0 +1,101 @@
+package ga
+
+import (
+	"testing"
+)
+
+func TestFitnessCache(t *testing.T) {
+	t.Parallel()
+	cache := NewFitnessCache(2)
+	gene1 := Genome{Sequence: Bitstring{"1", "0"}}
+	gene2 := Genome{Sequence: Bitstring{"0", "1"}}
+	gene3 := Genome{Sequence: Bitstring{"1", "1"}}
+
+	if _, ok := cache.Get(gene1); ok {
+		t.Error("Empty cache returned a value")
+	}
+	cache.Put(gene1, 1)
+	cache.Put(gene2, 2)
+	if fitness, ok := cache.Get(gene1); !ok || fitness != 1 {
+		t.Error("Cached value not returned.", "Expected:", 1, "Got:", fitness, ok)
+	}
+
+	cache.Put(gene3, 3)
+	if cache.Len() != 2 {
+		t.Error("Cache grew past its capacity.", "Expected:", 2, "Got:", cache.Len())
+	}
+	if _, ok := cache.Get(gene2); ok {
+		t.Error("Least recently used entry was not evicted")
+	} else {
+		t.Log("Least recently used entry evicted")
+	}
+	if _, ok := cache.Get(gene1); !ok {
+		t.Error("Recently used entry was evicted")
+	}
+
+	if cache.Hits() != 2 || cache.Misses() != 2 {
+		t.Error("Incorrect counters.", "Expected hits:", 2, "misses:", 2, "Got hits:", cache.Hits(), "misses:", cache.Misses())
+	} else {
+		t.Log("Correct counters.", "Hits:", cache.Hits(), "Misses:", cache.Misses())
+	}
+
+	cache.Clear()
+	if cache.Len() != 0 || cache.Hits() != 0 || cache.Misses() != 0 {
+		t.Error("Cache not cleared.", "Len:", cache.Len(), "Hits:", cache.Hits(), "Misses:", cache.Misses())
+	}
+}
+
+func TestFitnessCacheKey(t *testing.T) {
+	t.Parallel()
+	cache := NewFitnessCache(10)
+	cache.Put(Genome{Sequence: Bitstring{"1", "01"}}, 1)
+	if _, ok := cache.Get(Genome{Sequence: Bitstring{"10", "1"}}); ok {
+		t.Error("Different sequences share a cache key")
+	}
+}
+
+func TestSetFitnessCache(t *testing.T) {
+	t.Parallel()
+	var genA = NewGeneticAlgorithm()
+	genA.SetSeed(3)
+	genA.SetOutputFunc(func(a ...interface{}) {})
+	calls := 0
+	genA.SetFitnessFunc(func(gene Genome) float64 {
+		calls++
+		return DefaultFitnessFunc(gene)
+	})
+	cache := NewFitnessCache(1000)
+	genA.SetFitnessCache(cache)
+
+	if err := genA.Run(10, 10, 10, true, true, false); err != nil {
+		t.Error("GA errored unexpectedly. Got:", err)
+	}
+
+	if cache.Hits() == 0 {
+		t.Error("Cache was never hit")
+	} else {
+		t.Log("Cache saved evaluations.", "Hits:", cache.Hits(), "Misses:", cache.Misses())
+	}
+	if uint64(calls) != cache.Misses() || genA.Evaluations != calls {
+		t.Error("Fitness called on cache hits.", "Calls:", calls, "Misses:", cache.Misses(), "Evaluations:", genA.Evaluations)
+	}
+
+	genA.SetFitnessFunc(DefaultFitnessFunc)
+	if cache.Len() != 0 {
+		t.Error("Cache not cleared when fitness function changed")
+	}
+}
+
+func TestFitnessCacheOf(t *testing.T) {
+	t.Parallel()
+	cache := NewFitnessCacheOf[int](10)
+	cache.Put(GenomeOf[int]{Sequence: Sequence[int]{1, 23}}, 1)
+	if _, ok := cache.Get(GenomeOf[int]{Sequence: Sequence[int]{12, 3}}); ok {
+		t.Error("Different sequences share a cache key")
+	}
+	if fitness, ok := cache.Get(GenomeOf[int]{Sequence: Sequence[int]{1, 23}}); !ok || fitness != 1 {
+		t.Error("Cached value not returned.", "Expected:", 1, "Got:", fitness, ok)
+	} else {
+		t.Log("Cached value returned.", "Expected:", 1, "Got:", fitness)
+	}
+}
@@ -0,0 +1,344 @@
+package ga
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+)
+
+// checkpointVersion is the version of the checkpoint format written by SaveCheckpoint
+const checkpointVersion = 1
+
+// checkpoint is the serialised state of a run between two generations
+type checkpoint[T comparable] struct {
+	Version               int
+	Config                RunConfig
+	Objective             Objective
+	Parsimony             float64
+	Candidates            []savedGenome[T]
+	BestCandidate         savedGenome[T]
+	Generations           int
+	IterationsSinceChange int
+	Evaluations           int
+	History               []savedStats[T]
+	Random                SourceState
+}
+
+// savedGenome is a GenomeOf as written to a checkpoint, with a fitness that need not be finite
+type savedGenome[T comparable] struct {
+	Sequence  Sequence[T]
+	Fitness   JSONFloat
+	Evaluated bool
+}
+
+func saveGenome[T comparable](gene GenomeOf[T]) savedGenome[T] {
+	return savedGenome[T]{gene.Sequence, JSONFloat(gene.Fitness), gene.Evaluated}
+}
+
+func (gene savedGenome[T]) load() GenomeOf[T] {
+	return GenomeOf[T]{gene.Sequence, float64(gene.Fitness), gene.Evaluated}
+}
+
+// savedStats is a GenerationStatsOf as written to a checkpoint, with statistics that need not be finite
+type savedStats[T comparable] struct {
+	Generation  int
+	Min         JSONFloat
+	Max         JSONFloat
+	Mean        JSONFloat
+	Median      JSONFloat
+	StdDev      JSONFloat
+	BestFitness JSONFloat
+	Best        savedGenome[T]
+	Unique      int
+	Evaluations int
+	Elapsed     time.Duration
+}
+
+func saveStats[T comparable](stats GenerationStatsOf[T]) savedStats[T] {
+	return savedStats[T]{
+		Generation:  stats.Generation,
+		Min:         JSONFloat(stats.Min),
+		Max:         JSONFloat(stats.Max),
+		Mean:        JSONFloat(stats.Mean),
+		Median:      JSONFloat(stats.Median),
+		StdDev:      JSONFloat(stats.StdDev),
+		BestFitness: JSONFloat(stats.BestFitness),
+		Best:        saveGenome(stats.Best),
+		Unique:      stats.Unique,
+		Evaluations: stats.Evaluations,
+		Elapsed:     stats.Elapsed,
+	}
+}
+
+func (stats savedStats[T]) load() GenerationStatsOf[T] {
+	return GenerationStatsOf[T]{
+		Generation:  stats.Generation,
+		Min:         float64(stats.Min),
+		Max:         float64(stats.Max),
+		Mean:        float64(stats.Mean),
+		Median:      float64(stats.Median),
+		StdDev:      float64(stats.StdDev),
+		BestFitness: float64(stats.BestFitness),
+		Best:        stats.Best.load(),
+		Unique:      stats.Unique,
+		Evaluations: stats.Evaluations,
+		Elapsed:     stats.Elapsed,
+	}
+}
+
+// SaveCheckpoint writes the state of the run as it was at the end of the last full generation to w as JSON,
+// so that it can be continued later with LoadCheckpoint and Resume.
+// The random state can only be saved when RandomEngine was created by SetSeed or LoadCheckpoint.
+// The FitnessCache is not saved, so a resumed run may count more Evaluations than an uninterrupted one
+func (genA *GeneticAlgorithmOf[T]) SaveCheckpoint(w io.Writer) error {
+	if genA.source == nil || genA.RandomEngine != genA.sourceEngine {
+		return errors.New("random engine was not created by SetSeed and cannot be saved")
+	}
+	if len(genA.Candidates) == 0 {
+		return errors.New("population is not initialised")
+	}
+	saved := checkpoint[T]{
+		Version:               checkpointVersion,
+		Config:                genA.Config,
+		Objective:             genA.Objective,
+		Parsimony:             genA.Parsimony,
+		BestCandidate:         saveGenome(genA.BestCandidate),
+		Generations:           genA.Generations,
+		IterationsSinceChange: genA.IterationsSinceChange,
+		Evaluations:           genA.Evaluations,
+		Random:                genA.generationState,
+	}
+	for _, val := range genA.Candidates {
+		saved.Candidates = append(saved.Candidates, saveGenome(val))
+	}
+	for _, val := range genA.History {
+		saved.History = append(saved.History, saveStats(val))
+	}
+	return json.NewEncoder(w).Encode(saved)
+}
+
+// LoadCheckpoint restores a run saved by SaveCheckpoint, replacing the population, config, objective, parsimony,
+// history and random state.
+// The functions, hooks and logger of the GA are left as they are, and must match those of the saved run for Resume
+// to continue it identically
+func (genA *GeneticAlgorithmOf[T]) LoadCheckpoint(r io.Reader) error {
+	var saved checkpoint[T]
+	if err := json.NewDecoder(r).Decode(&saved); err != nil {
+		return fmt.Errorf("reading checkpoint: %w", err)
+	}
+	if saved.Version != checkpointVersion {
+		return fmt.Errorf("unsupported checkpoint version %v", saved.Version)
+	}
+	if err := saved.Config.Validate(); err != nil {
+		return err
+	}
+	if len(saved.Candidates) != saved.Config.PopulationSize {
+		return fmt.Errorf("checkpoint has %v candidates, expected %v", len(saved.Candidates), saved.Config.PopulationSize)
+	}
+
+	genA.Config = saved.Config
+	genA.Objective = saved.Objective
+	genA.Parsimony = saved.Parsimony
+	genA.Candidates = make(PopulationOf[T], 0, len(saved.Candidates))
+	for _, val := range saved.Candidates {
+		genA.Candidates = append(genA.Candidates, val.load())
+	}
+	genA.BestCandidate = saved.BestCandidate.load()
+	genA.Generations = saved.Generations
+	genA.IterationsSinceChange = saved.IterationsSinceChange
+	genA.Evaluations = saved.Evaluations
+	genA.History = make([]GenerationStatsOf[T], 0, len(saved.History))
+	for _, val := range saved.History {
+		genA.History = append(genA.History, val.load())
+	}
+	genA.source = NewSource(saved.Random.Seed)
+	genA.source.SetState(saved.Random)
+	genA.RandomEngine = rand.New(genA.source)
+	genA.sourceEngine = genA.RandomEngine
+	genA.generationState = saved.Random
+	genA.started = time.Now()
+	if len(saved.History) > 0 {
+		genA.started = genA.started.Add(-genA.History[len(genA.History)-1].Elapsed)
+	}
+	return nil
+}
+
+// Resume continues a run restored by LoadCheckpoint until Config.Generations is reached or it terminates early.
+// The TimeLimit includes the time the run took before it was saved
+func (genA *GeneticAlgorithmOf[T]) Resume() error {
+	return genA.ResumeContext(context.Background())
+}
+
+// ResumeContext behaves like Resume, with the cancellation behaviour of RunContext
+func (genA *GeneticAlgorithmOf[T]) ResumeContext(ctx context.Context) error {
+	if err := genA.validate(genA.Config); err != nil {
+		return err
+	}
+	if len(genA.Candidates) == 0 {
+		return errors.New("population is not initialised")
+	}
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return genA.loop(ctx)
+}
+
+// ErrNoCheckpoint is returned by LoadLatestCheckpoint when a directory holds no valid checkpoint
+var ErrNoCheckpoint = errors.New("no valid checkpoint found")
+
+// CheckpointConfig controls the checkpoints written automatically during a run
+type CheckpointConfig struct {
+	// Dir is the directory checkpoint files are written to
+	Dir string
+	// Every writes a checkpoint after every Every generations. Zero disables it
+	Every int
+	// Interval writes a checkpoint once Interval has passed since the last one. Zero disables it
+	Interval time.Duration
+	// Keep is the number of checkpoint files kept in Dir, removing the oldest. Zero keeps them all
+	Keep int
+}
+
+// enabled reports whether any checkpoints are to be written
+func (config CheckpointConfig) enabled() bool {
+	return config.Every > 0 || config.Interval > 0
+}
+
+// validate checks that config describes where and how often to write checkpoints
+func (config CheckpointConfig) validate() error {
+	switch {
+	case config.Every < 0:
+		return errors.New("checkpoint interval in generations cannot be negative")
+	case config.Interval < 0:
+		return errors.New("checkpoint interval cannot be negative")
+	case config.Keep < 0:
+		return errors.New("number of checkpoints kept cannot be negative")
+	case config.enabled() && config.Dir == "":
+		return errors.New("checkpoint directory is not set")
+	}
+	return nil
+}
+
+// SetCheckpointing makes Run and Resume write checkpoints to a directory as described by config.
+// A zero CheckpointConfig disables checkpointing
+func (genA *GeneticAlgorithmOf[T]) SetCheckpointing(config CheckpointConfig) {
+	genA.Checkpoints = config
+}
+
+// checkpointDue reports whether a checkpoint should be written at the end of the current generation
+func (genA *GeneticAlgorithmOf[T]) checkpointDue() bool {
+	config := genA.Checkpoints
+	if config.Every > 0 && genA.Generations%config.Every == 0 {
+		return true
+	}
+	return config.Interval > 0 && time.Since(genA.lastCheckpoint) >= config.Interval
+}
+
+// checkpointName returns the file name of the checkpoint for generation, which sorts in generation order
+func checkpointName(generation int) string {
+	return fmt.Sprintf("checkpoint-%010d.json", generation)
+}
+
+// WriteCheckpointFile atomically writes a checkpoint of the current generation to dir, replacing any checkpoint
+// of the same generation, and removes the oldest checkpoints beyond keep. A keep of zero keeps them all
+func (genA *GeneticAlgorithmOf[T]) WriteCheckpointFile(dir string, keep int) (string, error) {
+	file, err := os.CreateTemp(dir, "checkpoint-*.tmp")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(file.Name())
+	if err := genA.SaveCheckpoint(file); err != nil {
+		file.Close()
+		return "", err
+	}
+	if err := file.Sync(); err != nil {
+		file.Close()
+		return "", err
+	}
+	if err := file.Close(); err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, checkpointName(genA.Generations))
+	if err := os.Rename(file.Name(), path); err != nil {
+		return "", err
+	}
+	genA.lastCheckpoint = time.Now()
+	if keep > 0 {
+		return path, pruneCheckpoints(dir, keep)
+	}
+	return path, nil
+}
+
+// checkpointFiles returns the checkpoint files in dir, newest first. Files are ordered by modification time, so that
+// checkpoints left in dir by an earlier, longer run are older than those of the current run, and then by generation
+func checkpointFiles(dir string) ([]string, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "checkpoint-*.json"))
+	if err != nil {
+		return nil, err
+	}
+	modified := make(map[string]time.Time, len(paths))
+	for _, path := range paths {
+		if info, err := os.Stat(path); err == nil {
+			modified[path] = info.ModTime()
+		}
+	}
+	sort.Slice(paths, func(i, j int) bool {
+		if a, b := modified[paths[i]], modified[paths[j]]; !a.Equal(b) {
+			return a.After(b)
+		}
+		return paths[i] > paths[j]
+	})
+	return paths, nil
+}
+
+// pruneCheckpoints removes all but the newest keep checkpoint files in dir
+func pruneCheckpoints(dir string, keep int) error {
+	paths, err := checkpointFiles(dir)
+	if err != nil {
+		return err
+	}
+	for len(paths) > keep {
+		if err := os.Remove(paths[len(paths)-1]); err != nil {
+			return err
+		}
+		paths = paths[:len(paths)-1]
+	}
+	return nil
+}
+
+// LoadLatestCheckpoint loads the newest checkpoint in dir that can be read, skipping any that are corrupt,
+// and returns its path. ErrNoCheckpoint is returned when there is none
+func (genA *GeneticAlgorithmOf[T]) LoadLatestCheckpoint(dir string) (string, error) {
+	paths, err := checkpointFiles(dir)
+	if err != nil {
+		return "", err
+	}
+	for _, path := range paths {
+		file, err := os.Open(path)
+		if err != nil {
+			continue
+		}
+		err = genA.LoadCheckpoint(file)
+		file.Close()
+		if err == nil {
+			return path, nil
+		}
+		genA.Logger.Warn("skipping unreadable checkpoint", "path", path, "error", err)
+	}
+	return "", ErrNoCheckpoint
+}
+
+// ResumeLatestCheckpoint loads the newest valid checkpoint in dir and resumes the run from it.
+// ErrNoCheckpoint is returned when there is none, in which case a new run can be started instead
+func (genA *GeneticAlgorithmOf[T]) ResumeLatestCheckpoint(ctx context.Context, dir string) error {
+	if _, err := genA.LoadLatestCheckpoint(dir); err != nil {
+		return err
+	}
+	return genA.ResumeContext(ctx)
+}
@@ -0,0 +1,366 @@
+package ga
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func newCheckpointGA(seed int64) GeneticAlgorithm {
+	var genA = NewGeneticAlgorithm()
+	genA.SetSeed(seed)
+	genA.SetOutputFunc(func(a ...interface{}) {})
+	config := NewRunConfig(20, 20, 30)
+	config.EliteCount = 2
+	config.MutationProbability = 0.05
+	genA.SetConfig(config)
+	return genA
+}
+
+func TestCheckpoint_Resume(t *testing.T) {
+	t.Parallel()
+	expected := newCheckpointGA(3)
+	if err := expected.RunWithConfig(expected.Config); err != nil {
+		t.Fatal("GA errored unexpectedly. Got:", err)
+	}
+
+	interrupted := newCheckpointGA(3)
+	if err := interrupted.Init(20, 20); err != nil {
+		t.Fatal("Init errored unexpectedly. Got:", err)
+	}
+	for i := 0; i < 10; i++ {
+		interrupted.Step()
+	}
+	var buffer bytes.Buffer
+	if err := interrupted.SaveCheckpoint(&buffer); err != nil {
+		t.Fatal("SaveCheckpoint errored unexpectedly. Got:", err)
+	}
+
+	resumed := newCheckpointGA(99)
+	if err := resumed.LoadCheckpoint(&buffer); err != nil {
+		t.Fatal("LoadCheckpoint errored unexpectedly. Got:", err)
+	}
+	if resumed.Generations != 10 {
+		t.Error("Generations not restored.", "Expected:", 10, "Got:", resumed.Generations)
+	}
+	if err := resumed.Resume(); err != nil {
+		t.Fatal("Resume errored unexpectedly. Got:", err)
+	}
+
+	compareRuns(t, expected, resumed)
+}
+
+func TestCheckpoint_CancelledStep(t *testing.T) {
+	t.Parallel()
+	expected := newCheckpointGA(3)
+	if err := expected.RunWithConfig(expected.Config); err != nil {
+		t.Fatal("GA errored unexpectedly. Got:", err)
+	}
+
+	interrupted := newCheckpointGA(3)
+	ctx, cancel := context.WithCancel(context.Background())
+	interrupted.SetHooks(Hooks{OnCrossover: func(event Event) {
+		if event.Generation == 5 {
+			cancel()
+		}
+	}})
+	if err := interrupted.RunWithConfigContext(ctx, interrupted.Config); err != context.Canceled {
+		t.Fatal("Expected:", context.Canceled, "Got:", err)
+	}
+	var buffer bytes.Buffer
+	if err := interrupted.SaveCheckpoint(&buffer); err != nil {
+		t.Fatal("SaveCheckpoint errored unexpectedly. Got:", err)
+	}
+
+	resumed := newCheckpointGA(99)
+	if err := resumed.LoadCheckpoint(&buffer); err != nil {
+		t.Fatal("LoadCheckpoint errored unexpectedly. Got:", err)
+	}
+	if err := resumed.Resume(); err != nil {
+		t.Fatal("Resume errored unexpectedly. Got:", err)
+	}
+
+	compareRuns(t, expected, resumed)
+}
+
+func TestCheckpoint_NonFinite(t *testing.T) {
+	t.Parallel()
+	newGA := func(seed int64) GeneticAlgorithm {
+		genA := newCheckpointGA(seed)
+		genA.SetFitnessFunc(func(gene Genome) float64 {
+			if gene.Sequence[0] == "0" {
+				return math.Inf(-1)
+			}
+			return DefaultFitnessFunc(gene)
+		})
+		return genA
+	}
+	saved := newGA(3)
+	if err := saved.Init(20, 20); err != nil {
+		t.Fatal("Init errored unexpectedly. Got:", err)
+	}
+	var buffer bytes.Buffer
+	if err := saved.SaveCheckpoint(&buffer); err != nil {
+		t.Fatal("SaveCheckpoint errored unexpectedly. Got:", err)
+	}
+
+	loaded := newGA(99)
+	if err := loaded.LoadCheckpoint(&buffer); err != nil {
+		t.Fatal("LoadCheckpoint errored unexpectedly. Got:", err)
+	}
+	if fmt.Sprint(saved.Candidates) != fmt.Sprint(loaded.Candidates) || !math.IsInf(loaded.History[0].Min, -1) {
+		t.Error("Non-finite fitness not restored.", "Expected:", saved.Candidates, "Got:", loaded.Candidates)
+	} else {
+		t.Log("Non-finite fitness restored.", "Min:", loaded.History[0].Min)
+	}
+}
+
+func TestCheckpoint_Settings(t *testing.T) {
+	t.Parallel()
+	saved := newCheckpointGA(3)
+	saved.SetObjective(Minimise)
+	saved.SetParsimony(0.5)
+	if err := saved.Init(20, 20); err != nil {
+		t.Fatal("Init errored unexpectedly. Got:", err)
+	}
+	var buffer bytes.Buffer
+	if err := saved.SaveCheckpoint(&buffer); err != nil {
+		t.Fatal("SaveCheckpoint errored unexpectedly. Got:", err)
+	}
+
+	loaded := newCheckpointGA(99)
+	if err := loaded.LoadCheckpoint(&buffer); err != nil {
+		t.Fatal("LoadCheckpoint errored unexpectedly. Got:", err)
+	}
+	if loaded.Objective != Minimise || loaded.Parsimony != 0.5 {
+		t.Error("Settings not restored.", "Expected:", Minimise, 0.5, "Got:", loaded.Objective, loaded.Parsimony)
+	} else {
+		t.Log("Settings restored.", "Objective:", loaded.Objective, "Parsimony:", loaded.Parsimony)
+	}
+}
+
+func TestCheckpoint_TimeLimit(t *testing.T) {
+	t.Parallel()
+	interrupted := newCheckpointGA(3)
+	if err := interrupted.Init(20, 20); err != nil {
+		t.Fatal("Init errored unexpectedly. Got:", err)
+	}
+	// Pretend the run has already taken an hour
+	interrupted.started = interrupted.started.Add(-time.Hour)
+	interrupted.Step()
+	var buffer bytes.Buffer
+	if err := interrupted.SaveCheckpoint(&buffer); err != nil {
+		t.Fatal("SaveCheckpoint errored unexpectedly. Got:", err)
+	}
+
+	resumed := newCheckpointGA(99)
+	resumed.SetTimeLimit(30 * time.Minute)
+	var reason TerminationReason
+	resumed.SetHooks(Hooks{OnTerminate: func(event Event) {
+		reason = event.Reason
+	}})
+	if err := resumed.LoadCheckpoint(&buffer); err != nil {
+		t.Fatal("LoadCheckpoint errored unexpectedly. Got:", err)
+	}
+	if err := resumed.Resume(); err != nil {
+		t.Fatal("Resume errored unexpectedly. Got:", err)
+	}
+	if reason != TerminationTimeLimit || resumed.Generations != 2 {
+		t.Error("Resumed run ignored the time already taken.", "Expected:", TerminationTimeLimit, 2, "Got:", reason, resumed.Generations)
+	} else {
+		t.Log("Resumed run stopped at the time limit.", "Generations:", resumed.Generations)
+	}
+}
+
+func compareRuns(t *testing.T, expected, got GeneticAlgorithm) {
+	t.Helper()
+	if expected.Generations != got.Generations {
+		t.Error("Resumed run ran for a different number of generations.", "Expected:", expected.Generations, "Got:", got.Generations)
+	}
+	if expected.BestCandidate.String() != got.BestCandidate.String() {
+		t.Error("Resumed run found a different best candidate.", "Expected:", expected.BestCandidate, "Got:", got.BestCandidate)
+	}
+	if fmt.Sprint(expected.Candidates) != fmt.Sprint(got.Candidates) {
+		t.Error("Resumed run ended with a different population.", "Expected:", expected.Candidates, "Got:", got.Candidates)
+	}
+	if len(expected.History) != len(got.History) {
+		t.Error("Resumed run has a different history length.", "Expected:", len(expected.History), "Got:", len(got.History))
+	}
+	if !t.Failed() {
+		t.Log("Resumed run matched the uninterrupted run.", "Best:", got.BestCandidate)
+	}
+}
+
+func TestSaveCheckpoint_Errors(t *testing.T) {
+	t.Parallel()
+	t.Run("NotInitialised", func(t *testing.T) {
+		t.Parallel()
+		genA := newCheckpointGA(3)
+		if err := genA.SaveCheckpoint(&bytes.Buffer{}); err == nil {
+			t.Error("Saved a checkpoint of an uninitialised GA")
+		}
+	})
+	t.Run("UnserialisableRandom", func(t *testing.T) {
+		t.Parallel()
+		genA := newCheckpointGA(3)
+		genA.Init(20, 20)
+		genA.RandomEngine = rand.New(rand.NewSource(3))
+		if err := genA.SaveCheckpoint(&bytes.Buffer{}); err == nil {
+			t.Error("Saved a checkpoint without the random state")
+		}
+	})
+}
+
+func TestLoadCheckpoint_Errors(t *testing.T) {
+	t.Parallel()
+	testLoad := func(name, input string) {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			genA := newCheckpointGA(3)
+			if err := genA.LoadCheckpoint(strings.NewReader(input)); err == nil {
+				t.Error("Loaded an invalid checkpoint")
+			} else {
+				t.Log("Checkpoint rejected. Got:", err)
+			}
+		})
+	}
+	testLoad("Malformed", "{")
+	testLoad("Version", `{"Version": 99}`)
+	testLoad("InvalidConfig", `{"Version": 1, "Config": {"PopulationSize": 0}}`)
+	testLoad("MissingCandidates", `{"Version": 1, "Config": {"PopulationSize": 2, "BitstringLength": 2}}`)
+}
+
+func TestCheckpointing(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	expected := newCheckpointGA(3)
+	expected.SetCheckpointing(CheckpointConfig{Dir: dir, Every: 5, Keep: 3})
+	if err := expected.RunWithConfig(expected.Config); err != nil {
+		t.Fatal("GA errored unexpectedly. Got:", err)
+	}
+
+	files, _ := filepath.Glob(filepath.Join(dir, "*"))
+	expectedFiles := []string{checkpointName(20), checkpointName(25), checkpointName(30)}
+	gotFiles := make([]string, 0)
+	for _, file := range files {
+		gotFiles = append(gotFiles, filepath.Base(file))
+	}
+	if fmt.Sprint(expectedFiles) != fmt.Sprint(gotFiles) {
+		t.Error("Wrong checkpoint files kept.", "Expected:", expectedFiles, "Got:", gotFiles)
+	} else {
+		t.Log("Newest checkpoints kept.", "Expected:", expectedFiles, "Got:", gotFiles)
+	}
+
+	// Resuming from generation 20 must give the same result as the uninterrupted run
+	os.Remove(filepath.Join(dir, checkpointName(30)))
+	os.Remove(filepath.Join(dir, checkpointName(25)))
+	os.WriteFile(filepath.Join(dir, checkpointName(29)), []byte(`{"Version": 1, "Candid`), 0644)
+
+	resumed := newCheckpointGA(99)
+	path, err := resumed.LoadLatestCheckpoint(dir)
+	if err != nil {
+		t.Fatal("LoadLatestCheckpoint errored unexpectedly. Got:", err)
+	}
+	if filepath.Base(path) != checkpointName(20) {
+		t.Error("Corrupt checkpoint not skipped.", "Expected:", checkpointName(20), "Got:", filepath.Base(path))
+	}
+	if err := resumed.Resume(); err != nil {
+		t.Fatal("Resume errored unexpectedly. Got:", err)
+	}
+	compareRuns(t, expected, resumed)
+}
+
+func TestCheckpointing_SharedDir(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	stale := newCheckpointGA(5)
+	stale.SetCheckpointing(CheckpointConfig{Dir: dir, Every: 5})
+	if err := stale.RunWithConfig(stale.Config); err != nil {
+		t.Fatal("GA errored unexpectedly. Got:", err)
+	}
+	files, _ := filepath.Glob(filepath.Join(dir, "*"))
+	for _, file := range files {
+		os.Chtimes(file, time.Now().Add(-time.Hour), time.Now().Add(-time.Hour))
+	}
+
+	current := newCheckpointGA(3)
+	config := current.Config
+	config.Generations = 10
+	current.SetCheckpointing(CheckpointConfig{Dir: dir, Every: 5, Keep: 2})
+	if err := current.RunWithConfig(config); err != nil {
+		t.Fatal("GA errored unexpectedly. Got:", err)
+	}
+	for _, generation := range []int{5, 10} {
+		if _, err := os.Stat(filepath.Join(dir, checkpointName(generation))); err != nil {
+			t.Error("Checkpoint of the current run pruned.", "Expected:", checkpointName(generation), "Got:", err)
+		}
+	}
+
+	resumed := newCheckpointGA(99)
+	if _, err := resumed.LoadLatestCheckpoint(dir); err != nil {
+		t.Fatal("LoadLatestCheckpoint errored unexpectedly. Got:", err)
+	}
+	if resumed.Generations != 10 || resumed.BestCandidate.String() != current.BestCandidate.String() {
+		t.Error("Loaded the stale run.", "Expected:", 10, current.BestCandidate, "Got:", resumed.Generations, resumed.BestCandidate)
+	} else {
+		t.Log("Loaded the current run.", "Generations:", resumed.Generations)
+	}
+}
+
+func TestCheckpointing_Interval(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	genA := newCheckpointGA(3)
+	genA.SetCheckpointing(CheckpointConfig{Dir: dir, Interval: time.Nanosecond})
+	if err := genA.RunWithConfig(genA.Config); err != nil {
+		t.Fatal("GA errored unexpectedly. Got:", err)
+	}
+	files, _ := filepath.Glob(filepath.Join(dir, "checkpoint-*.json"))
+	if len(files) != genA.Generations {
+		t.Error("Checkpoint not written every interval.", "Expected:", genA.Generations, "Got:", len(files))
+	} else {
+		t.Log("Checkpoint written every interval.", "Expected:", genA.Generations, "Got:", len(files))
+	}
+}
+
+func TestCheckpointing_Invalid(t *testing.T) {
+	t.Parallel()
+	genA := newCheckpointGA(3)
+	genA.SetCheckpointing(CheckpointConfig{Every: 5})
+	if err := genA.RunWithConfig(genA.Config); err == nil {
+		t.Error("Run accepted checkpointing without a directory")
+	}
+}
+
+func TestResumeLatestCheckpoint(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	genA := newCheckpointGA(3)
+	if err := genA.ResumeLatestCheckpoint(context.Background(), dir); err != ErrNoCheckpoint {
+		t.Error("Expected:", ErrNoCheckpoint, "Got:", err)
+	}
+
+	expected := newCheckpointGA(3)
+	expected.RunWithConfig(expected.Config)
+	interrupted := newCheckpointGA(3)
+	interrupted.Init(20, 20)
+	interrupted.Step()
+	if _, err := interrupted.WriteCheckpointFile(dir, 0); err != nil {
+		t.Fatal("WriteCheckpointFile errored unexpectedly. Got:", err)
+	}
+	if err := genA.ResumeLatestCheckpoint(context.Background(), dir); err != nil {
+		t.Fatal("ResumeLatestCheckpoint errored unexpectedly. Got:", err)
+	}
+	compareRuns(t, expected, genA)
+
+	if temps, _ := filepath.Glob(filepath.Join(dir, "*.tmp")); len(temps) != 0 {
+		t.Error("Temporary files left behind. Got:", temps)
+	}
+}
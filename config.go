@@ -0,0 +1,86 @@
+package ga
+
+import "fmt"
+
+// RunConfig holds the parameters of a single run of the GA
+type RunConfig struct {
+	PopulationSize  int
+	BitstringLength int
+	Generations     int
+
+	Crossover      bool
+	Mutate         bool
+	TerminateEarly bool
+
+	// CrossoverProbability is the chance that a selected pair is crossed over, otherwise the parents are copied through
+	CrossoverProbability float64
+	// MutationProbability is the chance that each gene of an offspring is mutated by MutateGene.
+	// Zero does not disable mutation: it falls back to applying Mutate once to every offspring.
+	// Set Mutate to false to disable mutation
+	MutationProbability float64
+	// EliteCount is the number of fittest candidates carried unchanged into the next generation
+	EliteCount int
+	// StagnationThreshold is the number of generations without improvement allowed when TerminateEarly is set.
+	// Zero uses a quarter of Generations
+	StagnationThreshold int
+	// MaxEvaluations stops a run once it has made this many fitness evaluations. It is checked between generations,
+	// so a run can exceed it by up to one generation's evaluations. Zero means no limit
+	MaxEvaluations int
+}
+
+// ConfigError describes a field of a RunConfig that failed validation
+type ConfigError struct {
+	Field  string
+	Reason string
+}
+
+func (e *ConfigError) Error() string {
+	return fmt.Sprintf("invalid run config: %v %v", e.Field, e.Reason)
+}
+
+// NewRunConfig returns a RunConfig with crossover and mutation enabled, matching the defaults of Run
+func NewRunConfig(populationSize, bitstringLength, generations int) RunConfig {
+	return RunConfig{
+		PopulationSize:       populationSize,
+		BitstringLength:      bitstringLength,
+		Generations:          generations,
+		Crossover:            true,
+		Mutate:               true,
+		CrossoverProbability: 1,
+	}
+}
+
+// Validate returns a *ConfigError describing the first invalid field or combination of fields, or nil
+func (config RunConfig) Validate() error {
+	switch {
+	case config.PopulationSize <= 0:
+		return &ConfigError{"PopulationSize", "must be positive"}
+	case config.BitstringLength <= 0:
+		return &ConfigError{"BitstringLength", "must be positive"}
+	case config.Generations < 0:
+		return &ConfigError{"Generations", "cannot be negative"}
+	case config.CrossoverProbability < 0 || config.CrossoverProbability > 1:
+		return &ConfigError{"CrossoverProbability", "must be between 0 and 1"}
+	case config.MutationProbability < 0 || config.MutationProbability > 1:
+		return &ConfigError{"MutationProbability", "must be between 0 and 1"}
+	case config.Crossover && config.CrossoverProbability == 0:
+		return &ConfigError{"CrossoverProbability", "must be above 0 when crossover is enabled"}
+	case config.EliteCount < 0:
+		return &ConfigError{"EliteCount", "cannot be negative"}
+	case config.EliteCount > config.PopulationSize:
+		return &ConfigError{"EliteCount", "cannot exceed PopulationSize"}
+	case config.StagnationThreshold < 0:
+		return &ConfigError{"StagnationThreshold", "cannot be negative"}
+	case config.MaxEvaluations < 0:
+		return &ConfigError{"MaxEvaluations", "cannot be negative"}
+	}
+	return nil
+}
+
+// stagnationLimit returns the number of generations without improvement after which TerminateEarly stops a run
+func (config RunConfig) stagnationLimit() float32 {
+	if config.StagnationThreshold > 0 {
+		return float32(config.StagnationThreshold)
+	}
+	return float32(config.Generations) * 0.25
+}
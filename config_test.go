@@ -0,0 +1,120 @@
+package ga
+
+import (
+	"testing"
+)
+
+func TestNewRunConfig(t *testing.T) {
+	t.Parallel()
+	config := NewRunConfig(10, 10, 100)
+	if err := config.Validate(); err != nil {
+		t.Error("Default config failed validation. Got:", err)
+	} else {
+		t.Log("Default config passed validation.")
+	}
+}
+
+func TestRunConfig_Validate(t *testing.T) {
+	t.Parallel()
+	testValidate := func(name, expectedField string, modify func(*RunConfig)) {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			config := NewRunConfig(10, 10, 100)
+			modify(&config)
+			err := config.Validate()
+			configErr, ok := err.(*ConfigError)
+			if !ok {
+				t.Error("Expected *ConfigError. Got:", err)
+			} else if configErr.Field != expectedField {
+				t.Error("Wrong field reported.", "Expected:", expectedField, "Got:", configErr.Field)
+			} else {
+				t.Log("Config rejected as expected. Got:", err)
+			}
+		})
+	}
+
+	testValidate("ZeroPopulation", "PopulationSize", func(c *RunConfig) { c.PopulationSize = 0 })
+	testValidate("ZeroLength", "BitstringLength", func(c *RunConfig) { c.BitstringLength = 0 })
+	testValidate("NegativeGenerations", "Generations", func(c *RunConfig) { c.Generations = -1 })
+	testValidate("CrossoverProbabilityTooHigh", "CrossoverProbability", func(c *RunConfig) { c.CrossoverProbability = 1.5 })
+	testValidate("ZeroCrossoverProbabilityWithCrossover", "CrossoverProbability", func(c *RunConfig) { c.CrossoverProbability = 0 })
+	testValidate("NegativeMutationProbability", "MutationProbability", func(c *RunConfig) { c.MutationProbability = -0.1 })
+	testValidate("NegativeElites", "EliteCount", func(c *RunConfig) { c.EliteCount = -1 })
+	testValidate("TooManyElites", "EliteCount", func(c *RunConfig) { c.EliteCount = 11 })
+	testValidate("NegativeStagnation", "StagnationThreshold", func(c *RunConfig) { c.StagnationThreshold = -1 })
+	testValidate("NegativeEvaluations", "MaxEvaluations", func(c *RunConfig) { c.MaxEvaluations = -1 })
+
+	t.Run("OddPopulation", func(t *testing.T) {
+		t.Parallel()
+		config := NewRunConfig(9, 10, 100)
+		if err := config.Validate(); err != nil {
+			t.Error("Odd population rejected. Got:", err)
+		}
+	})
+}
+
+func TestRunWithConfig(t *testing.T) {
+	t.Parallel()
+	t.Run("InvalidConfig", func(t *testing.T) {
+		t.Parallel()
+		var genA = NewGeneticAlgorithm()
+		genA.SetOutputFunc(func(a ...interface{}) {})
+		err := genA.RunWithConfig(NewRunConfig(0, 10, 10))
+		if _, ok := err.(*ConfigError); !ok {
+			t.Error("Expected *ConfigError. Got:", err)
+		} else {
+			t.Log("Run rejected invalid config. Got:", err)
+		}
+	})
+	t.Run("StagnationThreshold", func(t *testing.T) {
+		t.Parallel()
+		var genA = NewGeneticAlgorithm()
+		genA.SetSeed(3)
+		genA.SetOutputFunc(func(a ...interface{}) {})
+		genA.SetFitnessFunc(func(gene Genome) float64 { return 0 })
+		config := NewRunConfig(10, 10, 100)
+		config.TerminateEarly = true
+		config.StagnationThreshold = 3
+		if err := genA.RunWithConfig(config); err != nil {
+			t.Error("GA errored unexpectedly. Got:", err)
+		}
+		if genA.Generations != 4 {
+			t.Error("GA did not stop at stagnation threshold.", "Expected:", 4, "Got:", genA.Generations)
+		} else {
+			t.Log("GA stopped at stagnation threshold.", "Expected:", 4, "Got:", genA.Generations)
+		}
+	})
+	t.Run("MaxEvaluations", func(t *testing.T) {
+		t.Parallel()
+		var genA = NewGeneticAlgorithm()
+		genA.SetSeed(3)
+		genA.SetOutputFunc(func(a ...interface{}) {})
+		config := NewRunConfig(10, 10, 100)
+		config.MaxEvaluations = 1
+		if err := genA.RunWithConfig(config); err != nil {
+			t.Error("GA errored unexpectedly. Got:", err)
+		}
+		if genA.Generations != 1 {
+			t.Error("GA did not stop at evaluation budget.", "Expected:", 1, "Got:", genA.Generations)
+		} else {
+			t.Log("GA stopped at evaluation budget.", "Expected:", 1, "Got:", genA.Generations, "Evaluations:", genA.Evaluations)
+		}
+	})
+	t.Run("MaxEvaluationsBetweenGenerations", func(t *testing.T) {
+		t.Parallel()
+		var genA = NewGeneticAlgorithm()
+		genA.SetSeed(3)
+		genA.SetOutputFunc(func(a ...interface{}) {})
+		config := NewRunConfig(50, 30, 100)
+		config.MaxEvaluations = 60
+		if err := genA.RunWithConfig(config); err != nil {
+			t.Error("GA errored unexpectedly. Got:", err)
+		}
+		// The initial population is within the budget, so the first generation runs in full and overshoots it
+		if genA.Generations != 1 || genA.History[0].Evaluations >= config.MaxEvaluations || genA.Evaluations <= config.MaxEvaluations {
+			t.Error("GA did not check the budget between generations.", "Expected generations:", 1, "Got:", genA.Generations, "Evaluations:", genA.History[0].Evaluations, genA.Evaluations)
+		} else {
+			t.Log("GA stopped after the generation that exceeded the budget.", "Evaluations:", genA.Evaluations)
+		}
+	})
+}
@@ -5,22 +5,43 @@ import (
 	"math/rand"
 )
 
-type CrossoverFunction func(Genome, Genome, *rand.Rand) (Population, error)
+type CrossoverFunctionOf[T comparable] func(GenomeOf[T], GenomeOf[T], *rand.Rand) (PopulationOf[T], error)
 
-var DefaultCrossoverFunc CrossoverFunction = func(gene, spouse Genome, random *rand.Rand) (Population, error) {
+// CrossoverFunction crosses over bitstring genomes
+type CrossoverFunction = CrossoverFunctionOf[string]
+
+var DefaultCrossoverFunc CrossoverFunction = OnePointCrossover[string]
+
+// OnePointCrossover swaps the genes of two equal length genomes after a random point
+func OnePointCrossover[T comparable](gene, spouse GenomeOf[T], random *rand.Rand) (PopulationOf[T], error) {
 	gene = gene.Copy()
 	spouse = spouse.Copy()
 	if len(gene.Sequence) != len(spouse.Sequence) {
 		return nil, errors.New("strings are not same length")
 	}
 	crossover := random.Int() % len(gene.Sequence)
-	return Population{
-		{append(append(make(Bitstring, 0), gene.Sequence[:crossover]...), spouse.Sequence[crossover:]...)},
-		{append(append(make(Bitstring, 0), spouse.Sequence[:crossover]...), gene.Sequence[crossover:]...)},
+	return PopulationOf[T]{
+		{Sequence: append(append(make(Sequence[T], 0), gene.Sequence[:crossover]...), spouse.Sequence[crossover:]...)},
+		{Sequence: append(append(make(Sequence[T], 0), spouse.Sequence[:crossover]...), gene.Sequence[crossover:]...)},
 	}, nil
 }
 
+// UniformCrossover swaps each gene of two equal length genomes with probability 0.5
+func UniformCrossover[T comparable](gene, spouse GenomeOf[T], random *rand.Rand) (PopulationOf[T], error) {
+	if len(gene.Sequence) != len(spouse.Sequence) {
+		return nil, errors.New("strings are not same length")
+	}
+	gene = gene.Copy()
+	spouse = spouse.Copy()
+	for i := range gene.Sequence {
+		if random.Intn(2) == 0 {
+			gene.Sequence[i], spouse.Sequence[i] = spouse.Sequence[i], gene.Sequence[i]
+		}
+	}
+	return PopulationOf[T]{{Sequence: gene.Sequence}, {Sequence: spouse.Sequence}}, nil
+}
+
 // SetCrossoverFunc changes the crossover function to the function specified
-func (genA *GeneticAlgorithm) SetCrossoverFunc(f CrossoverFunction) {
+func (genA *GeneticAlgorithmOf[T]) SetCrossoverFunc(f CrossoverFunctionOf[T]) {
 	genA.Crossover = f
 }
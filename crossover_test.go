@@ -12,8 +12,8 @@ func TestDefaultCrossover(t *testing.T) {
 	genA.SetSeed(3)
 	genA.SetOutputFunc(func(a ...interface{}) { t.Log(a...) })
 	candidatePool := Population{
-		{Bitstring{"1", "0", "0", "0"}},
-		{Bitstring{"0", "0", "0", "1"}},
+		{Sequence: Bitstring{"1", "0", "0", "0"}},
+		{Sequence: Bitstring{"0", "0", "0", "1"}},
 	}
 	offspring, err := genA.Crossover(candidatePool[0], candidatePool[1], genA.RandomEngine)
 
@@ -48,8 +48,8 @@ func TestBadDefaultCrossover(t *testing.T) {
 	genA.SetOutputFunc(func(a ...interface{}) { t.Log(a...) })
 
 	candidatePool := Population{
-		{Bitstring{"1", "0", "0", "0"}},
-		{Bitstring{"0", "0", "0"}},
+		{Sequence: Bitstring{"1", "0", "0", "0"}},
+		{Sequence: Bitstring{"0", "0", "0"}},
 	}
 	_, err := genA.Crossover(candidatePool[0], candidatePool[1], genA.RandomEngine)
 	if err == nil {
@@ -66,7 +66,7 @@ func TestSetCrossoverFunc(t *testing.T) {
 	genA.SetOutputFunc(func(a ...interface{}) { t.Log(a...) })
 
 	genA.SetCrossoverFunc(func(gene, spouse Genome, random *rand.Rand) (Population, error) {
-		return Population{{Bitstring{"1", "2", "3", "4"}}}, nil
+		return Population{{Sequence: Bitstring{"1", "2", "3", "4"}}}, nil
 	})
 
 	expectedString := "[{[1 2 3 4 ]}]"
@@ -85,3 +85,55 @@ func TestSetCrossoverFunc(t *testing.T) {
 		t.Log("Crossover function set successfully.", "Expected:", expectedString, "Got:", gotString)
 	}
 }
+
+func TestCrossoverProbability(t *testing.T) {
+	t.Parallel()
+	var genA = NewGeneticAlgorithm()
+	genA.SetSeed(3)
+	genA.SetOutputFunc(func(a ...interface{}) {})
+	genA.SetSelectionFunc(func(Fitness FitnessFunction, genomes Population, random *rand.Rand) Population {
+		return genomes
+	})
+	crossovers := 0
+	genA.SetCrossoverFunc(func(gene, spouse Genome, random *rand.Rand) (Population, error) {
+		crossovers++
+		return DefaultCrossoverFunc(gene, spouse, random)
+	})
+
+	config := NewRunConfig(100, 10, 10)
+	config.Mutate = false
+	config.CrossoverProbability = 0.5
+	if err := genA.RunWithConfig(config); err != nil {
+		t.Error("GA errored unexpectedly. Got:", err)
+	}
+
+	pairs := config.PopulationSize / 2 * config.Generations
+	if crossovers < pairs*4/10 || crossovers > pairs*6/10 {
+		t.Error("Crossover rate far from probability.", "Expected about:", pairs/2, "Got:", crossovers)
+	} else {
+		t.Log("Crossover rate matches probability.", "Expected about:", pairs/2, "Got:", crossovers)
+	}
+}
+
+func TestUniformCrossover(t *testing.T) {
+	t.Parallel()
+	random := rand.New(rand.NewSource(3))
+	gene := Genome{Sequence: Bitstring{"1", "1", "1", "1", "1", "1", "1", "1"}}
+	spouse := Genome{Sequence: Bitstring{"0", "0", "0", "0", "0", "0", "0", "0"}}
+	expectedGene, expectedSpouse := fmt.Sprint(gene), fmt.Sprint(spouse)
+	offspring, err := UniformCrossover(gene, spouse, random)
+	if err != nil {
+		t.Fatal("Unexpected error:", err)
+	}
+	for i := range gene.Sequence {
+		if offspring[0].Sequence[i] == offspring[1].Sequence[i] {
+			t.Error("Children do not hold one gene from each parent.", "Locus:", i, "Got:", offspring)
+		}
+	}
+	if fmt.Sprint(gene) != expectedGene || fmt.Sprint(spouse) != expectedSpouse {
+		t.Error("Crossover modified its parents. Got:", gene, spouse)
+	}
+	if _, err := UniformCrossover(gene, Genome{Sequence: Bitstring{"0"}}, random); err == nil {
+		t.Error("Crossover accepted genomes of different lengths")
+	}
+}
@@ -0,0 +1,26 @@
+package ga
+
+import "fmt"
+
+// RunError describes an error returned by one of the GA's functions, and the generation and phase it occurred in
+type RunError struct {
+	Generation int
+	Phase      Phase
+	Err        error
+}
+
+func (e *RunError) Error() string {
+	return fmt.Sprintf("generation %v %v: %v", e.Generation, e.Phase, e.Err)
+}
+
+func (e *RunError) Unwrap() error {
+	return e.Err
+}
+
+// runError wraps err in a *RunError, or returns nil if err is nil
+func runError(generation int, phase Phase, err error) error {
+	if err == nil {
+		return nil
+	}
+	return &RunError{generation, phase, err}
+}
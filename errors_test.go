@@ -0,0 +1,125 @@
+package ga
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/rand"
+	"testing"
+)
+
+var errOperator = errors.New("operator failed")
+
+func TestRunError(t *testing.T) {
+	t.Parallel()
+	err := error(&RunError{Generation: 3, Phase: PhaseCrossover, Err: errOperator})
+	expected := "generation 3 crossover: operator failed"
+	if err.Error() != expected {
+		t.Error("Incorrect message.", "Expected:", expected, "Got:", err.Error())
+	}
+	if !errors.Is(err, errOperator) {
+		t.Error("RunError does not unwrap to its cause")
+	} else {
+		t.Log("RunError unwraps to its cause. Got:", err)
+	}
+}
+
+func TestRunErrors(t *testing.T) {
+	t.Parallel()
+	testRunError := func(name string, expectedGeneration int, expectedPhase Phase, modify func(*GeneticAlgorithm)) {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			var genA = NewGeneticAlgorithm()
+			genA.SetSeed(3)
+			genA.SetOutputFunc(func(a ...interface{}) {})
+			modify(&genA)
+
+			var before string
+			genA.SetHooks(Hooks{OnGenerationStart: func(event Event) {
+				before = fmt.Sprint(genA.Candidates)
+			}})
+			err := genA.Run(10, 10, 10, true, true, false)
+
+			var runErr *RunError
+			if !errors.As(err, &runErr) {
+				t.Fatal("Expected *RunError. Got:", err)
+			}
+			if runErr.Generation != expectedGeneration || runErr.Phase != expectedPhase || !errors.Is(err, errOperator) {
+				t.Error("Incorrect error.", "Expected:", expectedGeneration, expectedPhase, errOperator, "Got:", runErr.Generation, runErr.Phase, runErr.Err)
+			} else {
+				t.Log("Run returned the failing generation and phase. Got:", err)
+			}
+			if expectedGeneration > 0 && (genA.Generations != expectedGeneration-1 || fmt.Sprint(genA.Candidates) != before) {
+				t.Error("Failed generation changed the population.", "Expected generations:", expectedGeneration-1, "Got:", genA.Generations)
+			}
+		})
+	}
+
+	testRunError("GenerateCandidate", 0, PhaseInit, func(genA *GeneticAlgorithm) {
+		genA.SetGenerateCandidate(func(length int, random *rand.Rand) (Bitstring, error) {
+			return nil, errOperator
+		})
+	})
+	testRunError("InitialFitness", 0, PhaseInit, func(genA *GeneticAlgorithm) {
+		genA.SetFitnessErrFunc(func(gene Genome) (float64, error) {
+			return 0, errOperator
+		})
+	})
+	testRunError("Crossover", 1, PhaseCrossover, func(genA *GeneticAlgorithm) {
+		genA.SetCrossoverFunc(func(gene, spouse Genome, random *rand.Rand) (Population, error) {
+			return nil, errOperator
+		})
+	})
+	testRunError("Mutate", 1, PhaseMutation, func(genA *GeneticAlgorithm) {
+		genA.SetMutateErrFunc(func(gene Genome, random *rand.Rand) (Genome, error) {
+			return gene, errOperator
+		})
+	})
+	testRunError("OffspringFitness", 3, PhaseCrossover, func(genA *GeneticAlgorithm) {
+		genA.SetLogger(slog.New(slog.NewTextHandler(io.Discard, nil)))
+		genA.SetFitnessErrFunc(func(gene Genome) (float64, error) {
+			if genA.Generations == 2 {
+				return 0, errOperator
+			}
+			return DefaultFitnessFunc(gene), nil
+		})
+	})
+}
+
+func TestSetFitnessErrFunc(t *testing.T) {
+	t.Parallel()
+	var genA = NewGeneticAlgorithm()
+	genA.SetFitnessErrFunc(func(gene Genome) (float64, error) {
+		return 0, errOperator
+	})
+	if genA.Fitness(Genome{Sequence: Bitstring{"1"}}) != 0 {
+		t.Error("Fitness wrapper did not return the fitness of a failed evaluation")
+	}
+
+	genA.SetFitnessFunc(DefaultFitnessFunc)
+	if genA.FitnessErr != nil {
+		t.Error("SetFitnessFunc did not replace the failing fitness function")
+	} else {
+		t.Log("SetFitnessFunc replaced the failing fitness function")
+	}
+}
+
+func TestSetMutateErrFunc(t *testing.T) {
+	t.Parallel()
+	var genA = NewGeneticAlgorithm()
+	genA.SetMutateErrFunc(func(gene Genome, random *rand.Rand) (Genome, error) {
+		return Genome{}, errOperator
+	})
+	gene := Genome{Sequence: Bitstring{"1", "0"}}
+	if got := genA.Mutate(gene, genA.RandomEngine); got.String() != gene.String() {
+		t.Error("Mutate wrapper changed the gene on error.", "Expected:", gene, "Got:", got)
+	}
+
+	genA.SetMutateFunc(DefaultMutateFunc)
+	if genA.MutateErr != nil {
+		t.Error("SetMutateFunc did not replace the failing mutate function")
+	} else {
+		t.Log("SetMutateFunc replaced the failing mutate function")
+	}
+}
@@ -0,0 +1,100 @@
+package ga
+
+import "sync"
+
+// SetWorkers sets the number of goroutines used to evaluate a Population. Values below 2 evaluate sequentially
+func (genA *GeneticAlgorithmOf[T]) SetWorkers(workers int) {
+	genA.Workers = workers
+}
+
+// score returns the fitness of gene from the FitnessCache if present, otherwise from FitnessErr if set, or Fitness.
+// evaluated reports whether the fitness function was called.
+// score is safe to call from multiple goroutines as long as the fitness function is
+func (genA *GeneticAlgorithmOf[T]) score(gene GenomeOf[T]) (fitness float64, evaluated bool, err error) {
+	if genA.FitnessCache != nil {
+		if fitness, ok := genA.FitnessCache.Get(gene); ok {
+			return genA.parsimony(gene, fitness), false, nil
+		}
+	}
+	if genA.FitnessErr != nil {
+		if fitness, err = genA.FitnessErr(gene); err != nil {
+			return 0, true, err
+		}
+	} else {
+		fitness = genA.Fitness(gene)
+	}
+	// Only successful evaluations are cached, before the parsimony penalty is applied
+	if genA.FitnessCache != nil {
+		genA.FitnessCache.Put(gene, fitness)
+	}
+	return genA.parsimony(gene, fitness), true, nil
+}
+
+// EvaluatePopulation scores every candidate in candidatePool that is not yet evaluated, spreading the work over
+// the GA's Workers, and stores the result on each Genome. Identical genomes are scored once, and fitness values are
+// returned in the order of candidatePool, so the result does not depend on the number of workers.
+// Candidates that fail to evaluate are left unevaluated and score 0, and the first error is recorded for the current
+// step to return
+func (genA *GeneticAlgorithmOf[T]) EvaluatePopulation(candidatePool PopulationOf[T]) []float64 {
+	var (
+		unique  []GenomeOf[T]
+		indexes = make([]int, len(candidatePool))
+		seen    = make(map[string]int)
+	)
+	for i, val := range candidatePool {
+		if val.Evaluated {
+			indexes[i] = -1
+			continue
+		}
+		key := cacheKey(val)
+		index, ok := seen[key]
+		if !ok {
+			index = len(unique)
+			seen[key] = index
+			unique = append(unique, val)
+		}
+		indexes[i] = index
+	}
+
+	uniqueFitness := make([]float64, len(unique))
+	evaluated := make([]bool, len(unique))
+	errs := make([]error, len(unique))
+	if genA.Workers < 2 {
+		for i, val := range unique {
+			uniqueFitness[i], evaluated[i], errs[i] = genA.score(val)
+		}
+	} else {
+		jobs := make(chan int)
+		var wait sync.WaitGroup
+		for w := 0; w < genA.Workers; w++ {
+			wait.Add(1)
+			go func() {
+				defer wait.Done()
+				for i := range jobs {
+					uniqueFitness[i], evaluated[i], errs[i] = genA.score(unique[i])
+				}
+			}()
+		}
+		for i := range unique {
+			jobs <- i
+		}
+		close(jobs)
+		wait.Wait()
+	}
+
+	for i, val := range evaluated {
+		if val {
+			genA.Evaluations++
+		}
+		genA.recordFitnessError(errs[i])
+	}
+	fitness := make([]float64, len(candidatePool))
+	for i, index := range indexes {
+		if index >= 0 && errs[index] == nil {
+			candidatePool[i].Fitness = uniqueFitness[index]
+			candidatePool[i].Evaluated = true
+		}
+		fitness[i] = candidatePool[i].Fitness
+	}
+	return fitness
+}
@@ -0,0 +1,112 @@
+package ga
+
+import (
+	"fmt"
+	"slices"
+	"sync/atomic"
+	"testing"
+)
+
+func TestEvaluatePopulation(t *testing.T) {
+	t.Parallel()
+	var genA = NewGeneticAlgorithm()
+	genA.SetSeed(3)
+	candidatePool, _ := genA.FillRandomPopulation(50, 20)
+
+	// Each call scores a fresh copy of the pool, as evaluated genomes are not scored again
+	genA.SetWorkers(1)
+	expected := fmt.Sprint(genA.EvaluatePopulation(slices.Clone(candidatePool)))
+	for _, workers := range []int{2, 4, 16} {
+		genA.SetWorkers(workers)
+		evaluations := genA.Evaluations
+		got := fmt.Sprint(genA.EvaluatePopulation(slices.Clone(candidatePool)))
+		if genA.Evaluations == evaluations {
+			t.Error("Population was not evaluated.", "Workers:", workers)
+		}
+		if got != expected {
+			t.Error("Fitness depends on number of workers.", "Workers:", workers, "Expected:", expected, "Got:", got)
+		} else {
+			t.Log("Fitness independent of number of workers.", "Workers:", workers)
+		}
+	}
+}
+
+func TestEvaluatePopulationDuplicates(t *testing.T) {
+	t.Parallel()
+	var genA = NewGeneticAlgorithm()
+	genA.SetWorkers(4)
+	var calls int64
+	genA.SetFitnessFunc(func(gene Genome) float64 {
+		atomic.AddInt64(&calls, 1)
+		return DefaultFitnessFunc(gene)
+	})
+
+	candidatePool := Population{
+		{Sequence: Bitstring{"1", "1", "1", "1"}},
+		{Sequence: Bitstring{"0", "1", "1", "1"}},
+		{Sequence: Bitstring{"1", "1", "1", "1"}},
+		{Sequence: Bitstring{"0", "1", "1", "1"}},
+	}
+	expected := "[4 3 4 3]"
+	got := fmt.Sprint(genA.EvaluatePopulation(candidatePool))
+	if got != expected {
+		t.Error("Incorrect fitness.", "Expected:", expected, "Got:", got)
+	}
+	if calls != 2 || genA.Evaluations != 2 {
+		t.Error("Identical genomes evaluated more than once.", "Calls:", calls, "Evaluations:", genA.Evaluations)
+	} else {
+		t.Log("Identical genomes evaluated once.", "Calls:", calls, "Evaluations:", genA.Evaluations)
+	}
+}
+
+func TestParallelRun(t *testing.T) {
+	t.Parallel()
+	run := func(workers int) string {
+		var genA = NewGeneticAlgorithm()
+		genA.SetSeed(3)
+		genA.SetOutputFunc(func(a ...interface{}) {})
+		genA.SetWorkers(workers)
+		if err := genA.Run(20, 20, 20, true, true, false); err != nil {
+			t.Error("GA errored unexpectedly. Got:", err)
+		}
+		return fmt.Sprint(genA.BestCandidate, genA.Candidates)
+	}
+
+	expected := run(1)
+	got := run(8)
+	if got != expected {
+		t.Error("Run depends on number of workers.", "Expected:", expected, "Got:", got)
+	} else {
+		t.Log("Run independent of number of workers.")
+	}
+}
+
+func TestEvaluateOncePerIndividual(t *testing.T) {
+	t.Parallel()
+	var genA = NewGeneticAlgorithm()
+	genA.SetSeed(3)
+	genA.SetOutputFunc(func(a ...interface{}) {})
+	calls := 0
+	genA.SetFitnessFunc(func(gene Genome) float64 {
+		calls++
+		return DefaultFitnessFunc(gene)
+	})
+
+	populationSize, generations := 10, 10
+	if err := genA.Run(populationSize, 10, generations, true, true, false); err != nil {
+		t.Error("GA errored unexpectedly. Got:", err)
+	}
+
+	// The initial population, then each generation's crossover and mutation offspring
+	maxCalls := populationSize + generations*populationSize*2
+	if calls > maxCalls {
+		t.Error("Individuals evaluated more than once.", "Expected at most:", maxCalls, "Got:", calls)
+	} else {
+		t.Log("Individuals evaluated once.", "Expected at most:", maxCalls, "Got:", calls)
+	}
+	for i, val := range genA.Candidates {
+		if !val.Evaluated {
+			t.Error("Candidate:", i, "left unevaluated. Got:", val)
+		}
+	}
+}
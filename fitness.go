@@ -1,47 +1,158 @@
 package ga
 
-type FitnessFunction func(gene Genome) int
+type FitnessFunctionOf[T comparable] func(gene GenomeOf[T]) float64
 
-var DefaultFitnessFunc FitnessFunction = func(gene Genome) int {
+// FitnessFunction scores bitstring genomes
+type FitnessFunction = FitnessFunctionOf[string]
+
+// FitnessErrFunctionOf is a fitness function that can fail. An error stops the run with a *RunError
+type FitnessErrFunctionOf[T comparable] func(gene GenomeOf[T]) (float64, error)
+
+// FitnessErrFunction scores bitstring genomes and can fail
+type FitnessErrFunction = FitnessErrFunctionOf[string]
+
+// Objective is the direction in which the GA optimises fitness
+type Objective int
+
+const (
+	Maximise Objective = iota
+	Minimise
+)
+
+var DefaultFitnessFunc FitnessFunction = func(gene Genome) float64 {
 	count := 0
 	for _, i := range gene.Sequence {
 		if i == "1" {
 			count++
 		}
 	}
-	return count
+	return float64(count)
 }
 
-// SetFitnessFunc changes the fitness function to the function specified
-func (genA *GeneticAlgorithm) SetFitnessFunc(f FitnessFunction) {
+// IntFitness adapts a fitness function returning int into a FitnessFunction
+func IntFitness[T comparable](f func(gene GenomeOf[T]) int) FitnessFunctionOf[T] {
+	return func(gene GenomeOf[T]) float64 {
+		return float64(f(gene))
+	}
+}
+
+// SetFitnessFunc changes the fitness function to the function specified, clearing any FitnessCache
+func (genA *GeneticAlgorithmOf[T]) SetFitnessFunc(f FitnessFunctionOf[T]) {
 	genA.Fitness = f
+	genA.FitnessErr = nil
+	if genA.FitnessCache != nil {
+		genA.FitnessCache.Clear()
+	}
+}
+
+// SetFitnessErrFunc changes the fitness function to one that can fail, clearing any FitnessCache.
+// Fitness is set to a wrapper around f that ignores errors, for callers that score genomes directly
+func (genA *GeneticAlgorithmOf[T]) SetFitnessErrFunc(f FitnessErrFunctionOf[T]) {
+	genA.SetFitnessFunc(func(gene GenomeOf[T]) float64 {
+		fitness, _ := f(gene)
+		return fitness
+	})
+	genA.FitnessErr = f
+}
+
+// SetObjective sets whether the GA maximises or minimises fitness
+func (genA *GeneticAlgorithmOf[T]) SetObjective(objective Objective) {
+	genA.Objective = objective
+}
+
+// better reports whether fitness a is preferable to fitness b under the GA's Objective
+func (genA *GeneticAlgorithmOf[T]) better(a, b float64) bool {
+	if genA.Objective == Minimise {
+		return a < b
+	}
+	return a > b
+}
+
+// evaluate returns the stored fitness of an evaluated gene, otherwise scores it with the fitness function,
+// counting the call towards Evaluations. Genomes found in the FitnessCache are not re-evaluated.
+// A failed evaluation scores 0 and its error is recorded for the current step to return
+func (genA *GeneticAlgorithmOf[T]) evaluate(gene GenomeOf[T]) float64 {
+	if gene.Evaluated {
+		return gene.Fitness
+	}
+	fitness, evaluated, err := genA.score(gene)
+	if evaluated {
+		genA.Evaluations++
+	}
+	genA.recordFitnessError(err)
+	return fitness
+}
+
+// recordFitnessError keeps the first error returned by FitnessErr until it is collected by takeFitnessError
+func (genA *GeneticAlgorithmOf[T]) recordFitnessError(err error) {
+	if genA.fitnessErr == nil {
+		genA.fitnessErr = err
+	}
+}
+
+// takeFitnessError returns and clears the error recorded by recordFitnessError
+func (genA *GeneticAlgorithmOf[T]) takeFitnessError() error {
+	err := genA.fitnessErr
+	genA.fitnessErr = nil
+	return err
+}
+
+// objectiveFitness scores gene so that higher is always better, negating fitness when minimising
+func (genA *GeneticAlgorithmOf[T]) objectiveFitness(gene GenomeOf[T]) float64 {
+	if genA.Objective == Minimise {
+		return -genA.evaluate(gene)
+	}
+	return genA.evaluate(gene)
 }
 
 // AverageFitness returns the average fitness of a [] Genome candidatePool
-func (genA *GeneticAlgorithm) AverageFitness(candidatePool Population) int {
-	var average int = 0
+func (genA *GeneticAlgorithmOf[T]) AverageFitness(candidatePool PopulationOf[T]) float64 {
+	var average float64 = 0
 	for _, i := range candidatePool {
-		average += genA.Fitness(i)
+		average += genA.evaluate(i)
 	}
-	return average / int(len(candidatePool))
+	return average / float64(len(candidatePool))
 }
 
-// MaxFitness returns the highest fitness found in a [] Genome candidatePool
-func (genA *GeneticAlgorithm) MaxFitnessCandidate(candidatePool Population) Genome {
+// MaxFitnessCandidate returns the candidate with the highest fitness in a [] Genome candidatePool, whatever the
+// GA's Objective. Use BestFitnessCandidate for the fittest candidate under the Objective
+func (genA *GeneticAlgorithmOf[T]) MaxFitnessCandidate(candidatePool PopulationOf[T]) GenomeOf[T] {
 	var (
-		max     int = 0
-		maxGene Genome
+		max     float64
+		maxGene GenomeOf[T]
 	)
-	for _, i := range candidatePool {
-		if genA.Fitness(i) > max {
-			max = genA.Fitness(i)
+	for index, i := range candidatePool {
+		if fitness := genA.evaluate(i); index == 0 || fitness > max {
+			max = fitness
 			maxGene = i
 		}
 	}
 	return maxGene
 }
 
-// MaxFitness returns the highest fitness found in a [] Genome candidatePool
-func (genA *GeneticAlgorithm) MaxFitness(candidatePool Population) int {
-	return genA.Fitness(genA.MaxFitnessCandidate(candidatePool))
+// MaxFitness returns the highest fitness found in a [] Genome candidatePool, whatever the GA's Objective.
+// Use BestFitness for the best fitness under the Objective
+func (genA *GeneticAlgorithmOf[T]) MaxFitness(candidatePool PopulationOf[T]) float64 {
+	return genA.evaluate(genA.MaxFitnessCandidate(candidatePool))
+}
+
+// BestFitnessCandidate returns the fittest candidate in a [] Genome candidatePool according to the GA's Objective
+func (genA *GeneticAlgorithmOf[T]) BestFitnessCandidate(candidatePool PopulationOf[T]) GenomeOf[T] {
+	var (
+		best     float64
+		bestGene GenomeOf[T]
+	)
+	for index, i := range candidatePool {
+		fitness := genA.evaluate(i)
+		if index == 0 || genA.better(fitness, best) {
+			best = fitness
+			bestGene = i
+		}
+	}
+	return bestGene
+}
+
+// BestFitness returns the best fitness found in a [] Genome candidatePool according to the GA's Objective
+func (genA *GeneticAlgorithmOf[T]) BestFitness(candidatePool PopulationOf[T]) float64 {
+	return genA.evaluate(genA.BestFitnessCandidate(candidatePool))
 }
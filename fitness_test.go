@@ -10,13 +10,13 @@ func TestGenome_DefaultFitness(t *testing.T) {
 	genA.SetSeed(3)
 	genA.SetOutputFunc(func(a ...interface{}) { t.Log(a...) })
 
-	genome := Genome{Bitstring{"1", "1", "1", "1"}}
+	genome := Genome{Sequence: Bitstring{"1", "1", "1", "1"}}
 
 	t.Log("Genome:", genome)
 	t.Log("Setting fitness func to default...")
 	genA.SetFitnessFunc(DefaultFitnessFunc)
 
-	expectedFitness := 4
+	expectedFitness := 4.0
 	gotFitness := genA.Fitness(genome)
 
 	if gotFitness != expectedFitness {
@@ -32,10 +32,10 @@ func TestGenome_CustomFitness(t *testing.T) {
 	genA.SetSeed(3)
 	genA.SetOutputFunc(func(a ...interface{}) { t.Log(a...) })
 
-	genome := Genome{Bitstring{"0", "0", "0", "1"}}
+	genome := Genome{Sequence: Bitstring{"0", "0", "0", "1"}}
 	t.Log(genome)
 	t.Log("Setting fitness func to custom...")
-	genA.SetFitnessFunc(func(gene Genome) int {
+	genA.SetFitnessFunc(IntFitness(func(gene Genome) int {
 		count := 0
 		for _, i := range gene.Sequence {
 			if i == "0" {
@@ -43,9 +43,9 @@ func TestGenome_CustomFitness(t *testing.T) {
 			}
 		}
 		return count
-	})
+	}))
 
-	expectedFitness := 3
+	expectedFitness := 3.0
 	gotFitness := genA.Fitness(genome)
 
 	if gotFitness != expectedFitness {
@@ -65,14 +65,14 @@ func TestAverageFitness(t *testing.T) {
 	genA.SetFitnessFunc(DefaultFitnessFunc)
 
 	candidatePool := Population{
-		{Bitstring{"1", "1", "1", "1"}},
-		{Bitstring{"1", "1", "1", "1"}},
-		{Bitstring{"0", "0", "0", "0"}},
-		{Bitstring{"0", "0", "0", "0"}},
+		{Sequence: Bitstring{"1", "1", "1", "1"}},
+		{Sequence: Bitstring{"1", "1", "1", "1"}},
+		{Sequence: Bitstring{"0", "0", "0", "0"}},
+		{Sequence: Bitstring{"0", "0", "0", "0"}},
 	}
 	t.Log("Created candidatePool:", candidatePool)
 
-	expectedFitness := 2
+	expectedFitness := 2.0
 	gotFitness := genA.AverageFitness(candidatePool)
 	if gotFitness != expectedFitness {
 		t.Error("Incorrect average fitness.", "Expected:", expectedFitness, "Got:", gotFitness)
@@ -91,14 +91,14 @@ func TestMaxFitness(t *testing.T) {
 	genA.SetFitnessFunc(DefaultFitnessFunc)
 
 	candidatePool := Population{
-		{Bitstring{"1", "1", "1", "1", "1", "1", "1", "1"}},
-		{Bitstring{"1", "1", "1", "1", "1", "1", "1", "1"}},
-		{Bitstring{"0", "0", "0", "0", "0", "0", "0", "0"}},
-		{Bitstring{"0", "0", "0", "0", "0", "0", "0", "0"}},
+		{Sequence: Bitstring{"1", "1", "1", "1", "1", "1", "1", "1"}},
+		{Sequence: Bitstring{"1", "1", "1", "1", "1", "1", "1", "1"}},
+		{Sequence: Bitstring{"0", "0", "0", "0", "0", "0", "0", "0"}},
+		{Sequence: Bitstring{"0", "0", "0", "0", "0", "0", "0", "0"}},
 	}
 	t.Log("Created candidatePool:", candidatePool)
 
-	expectedFitness := 8
+	expectedFitness := 8.0
 	gotFitness := genA.MaxFitness(candidatePool)
 	if gotFitness != expectedFitness {
 		t.Error("Incorrect max fitness.", "Expected:", expectedFitness, "Got:", gotFitness)
@@ -107,21 +107,21 @@ func TestMaxFitness(t *testing.T) {
 	}
 
 	t.Log("Setting fitness func to custom...")
-	genA.SetFitnessFunc(func(gene Genome) int {
+	genA.SetFitnessFunc(func(gene Genome) float64 {
 		count := 0
 		for _, i := range gene.Sequence {
 			if i == "0" {
 				count++
 			}
 		}
-		return count
+		return float64(count)
 	})
 
 	candidatePool = Population{
-		{Bitstring{"1", "1", "1", "1", "1", "1", "1", "1"}},
-		{Bitstring{"0", "0", "0", "0", "1", "1", "1", "1"}},
-		{Bitstring{"0", "0", "0", "0", "0", "0", "0", "0"}},
-		{Bitstring{"0", "0", "0", "0", "0", "0", "0", "0"}},
+		{Sequence: Bitstring{"1", "1", "1", "1", "1", "1", "1", "1"}},
+		{Sequence: Bitstring{"0", "0", "0", "0", "1", "1", "1", "1"}},
+		{Sequence: Bitstring{"0", "0", "0", "0", "0", "0", "0", "0"}},
+		{Sequence: Bitstring{"0", "0", "0", "0", "0", "0", "0", "0"}},
 	}
 	t.Log("Created candidatePool:", candidatePool)
 
@@ -133,3 +133,95 @@ func TestMaxFitness(t *testing.T) {
 		t.Log("Correct max fitness.", "Expected:", expectedFitness, "Got:", gotFitness)
 	}
 }
+
+func TestMaxFitness_Negative(t *testing.T) {
+	t.Parallel()
+	var genA = NewGeneticAlgorithm()
+	genA.SetSeed(3)
+	genA.SetOutputFunc(func(a ...interface{}) {})
+	genA.SetFitnessFunc(func(gene Genome) float64 {
+		return DefaultFitnessFunc(gene) - 10
+	})
+	candidatePool := Population{
+		{Sequence: Bitstring{"0", "0", "0", "0"}},
+		{Sequence: Bitstring{"1", "1", "0", "0"}},
+		{Sequence: Bitstring{"1", "0", "0", "0"}},
+	}
+
+	expected := candidatePool[1]
+	if got := genA.MaxFitnessCandidate(candidatePool); got.Sequence.String() != expected.Sequence.String() {
+		t.Error("Incorrect max fitness candidate.", "Expected:", expected, "Got:", got)
+	}
+	expectedFitness := -8.0
+	if gotFitness := genA.MaxFitness(candidatePool); gotFitness != expectedFitness {
+		t.Error("Incorrect max fitness.", "Expected:", expectedFitness, "Got:", gotFitness)
+	} else {
+		t.Log("Correct max fitness.", "Expected:", expectedFitness, "Got:", gotFitness)
+	}
+}
+
+func TestAverageFitnessNotTruncated(t *testing.T) {
+	t.Parallel()
+	var genA = NewGeneticAlgorithm()
+
+	candidatePool := Population{
+		{Sequence: Bitstring{"1", "1", "1", "1"}},
+		{Sequence: Bitstring{"1", "1", "1", "0"}},
+	}
+
+	expectedFitness := 3.5
+	gotFitness := genA.AverageFitness(candidatePool)
+	if gotFitness != expectedFitness {
+		t.Error("Incorrect average fitness.", "Expected:", expectedFitness, "Got:", gotFitness)
+	} else {
+		t.Log("Correct average fitness.", "Expected:", expectedFitness, "Got:", gotFitness)
+	}
+}
+
+func TestIntFitness(t *testing.T) {
+	t.Parallel()
+	var genA = NewGeneticAlgorithm()
+	genA.SetFitnessFunc(IntFitness(func(gene Genome) int {
+		return len(gene.Sequence) * 2
+	}))
+
+	expectedFitness := 8.0
+	gotFitness := genA.Fitness(Genome{Sequence: Bitstring{"0", "0", "0", "0"}})
+	if gotFitness != expectedFitness {
+		t.Error("Int fitness not adapted.", "Expected:", expectedFitness, "Got:", gotFitness)
+	} else {
+		t.Log("Int fitness adapted.", "Expected:", expectedFitness, "Got:", gotFitness)
+	}
+}
+
+func TestBestFitnessMinimise(t *testing.T) {
+	t.Parallel()
+	var genA = NewGeneticAlgorithm()
+	genA.SetObjective(Minimise)
+
+	candidatePool := Population{
+		{Sequence: Bitstring{"1", "1", "1", "1"}},
+		{Sequence: Bitstring{"0", "1", "1", "0"}},
+		{Sequence: Bitstring{"0", "1", "0", "0"}},
+		{Sequence: Bitstring{"1", "1", "1", "0"}},
+	}
+
+	expectedFitness := 1.0
+	gotFitness := genA.BestFitness(candidatePool)
+	if gotFitness != expectedFitness {
+		t.Error("Incorrect best fitness when minimising.", "Expected:", expectedFitness, "Got:", gotFitness)
+	} else {
+		t.Log("Correct best fitness when minimising.", "Expected:", expectedFitness, "Got:", gotFitness)
+	}
+
+	genA.UpdateBestCandidate(candidatePool[1])
+	genA.UpdateBestCandidate(candidatePool[2])
+	genA.UpdateBestCandidate(candidatePool[0])
+	expected := candidatePool[2].String()
+	got := genA.BestCandidate.String()
+	if got != expected {
+		t.Error("Best candidate not tracked when minimising.", "Expected:", expected, "Got:", got)
+	} else {
+		t.Log("Best candidate tracked when minimising.", "Expected:", expected, "Got:", got)
+	}
+}
@@ -0,0 +1,227 @@
+// Package ge implements grammatical evolution, mapping integer genomes evolved by the ga package
+// through a BNF grammar into programs or expressions
+package ge
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// Symbol is a terminal, whose Value is copied to the output, or a non-terminal, whose Value names a rule
+type Symbol struct {
+	Value    string
+	Terminal bool
+}
+
+// Production is one alternative of a rule
+type Production []Symbol
+
+// Grammar maps each non-terminal to its productions. Derivations begin at Start
+type Grammar struct {
+	Start string
+	Rules map[string][]Production
+}
+
+// LoadGrammar reads a Grammar from the BNF file at path. See ParseGrammar for the format
+func LoadGrammar(path string) (*Grammar, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+	return ParseGrammar(file)
+}
+
+// ParseGrammar reads a Grammar in BNF from r. Each rule has the form
+//
+//	<name> ::= alternative | alternative
+//
+// and may continue on following lines that begin with |. Non-terminals are written <name>, and all other text,
+// including whitespace between symbols, is terminal. Text in double quotes is always terminal, so it may contain
+// |, < or > or begin or end with whitespace, which is otherwise dropped from either end of an alternative. Blank lines and lines beginning with // are ignored. The first rule's non-terminal is the start symbol
+func ParseGrammar(r io.Reader) (*Grammar, error) {
+	grammar := &Grammar{Rules: make(map[string][]Production)}
+	var current string
+	scanner := bufio.NewScanner(r)
+	for number := 1; scanner.Scan(); number++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "//") {
+			continue
+		}
+		if strings.HasPrefix(line, "|") {
+			if current == "" {
+				return nil, fmt.Errorf("line %v: alternative before any rule", number)
+			}
+			line = line[1:]
+		} else {
+			name, alternatives, ok := strings.Cut(line, "::=")
+			name = strings.TrimSpace(name)
+			if !ok || !isNonTerminal(name) {
+				return nil, fmt.Errorf("line %v: expected <name> ::= alternatives", number)
+			}
+			current = name[1 : len(name)-1]
+			if _, defined := grammar.Rules[current]; defined {
+				return nil, fmt.Errorf("line %v: rule <%v> is defined twice", number, current)
+			}
+			grammar.Rules[current] = nil
+			if grammar.Start == "" {
+				grammar.Start = current
+			}
+			line = alternatives
+		}
+		productions, err := parseAlternatives(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %v: %w", number, err)
+		}
+		grammar.Rules[current] = append(grammar.Rules[current], productions...)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if err := grammar.Validate(); err != nil {
+		return nil, err
+	}
+	return grammar, nil
+}
+
+// isNonTerminal reports whether token is a non-terminal such as <name>
+func isNonTerminal(token string) bool {
+	return len(token) > 2 && token[0] == '<' && token[len(token)-1] == '>' && !strings.ContainsAny(token[1:len(token)-1], "<> \t")
+}
+
+// parseAlternatives splits text on the | separating alternatives and parses each into a Production
+func parseAlternatives(text string) ([]Production, error) {
+	var (
+		productions []Production
+		production  Production
+		literal     strings.Builder
+		// trailing is the length of the unquoted whitespace ending literal, dropped if the alternative ends there
+		trailing int
+	)
+	flush := func() {
+		if literal.Len() > 0 {
+			production = append(production, Symbol{Value: literal.String(), Terminal: true})
+			literal.Reset()
+		}
+		trailing = 0
+	}
+	end := func() error {
+		// Unquoted whitespace around an alternative separates it from | and is not part of it
+		if trailing > 0 {
+			value := literal.String()
+			literal.Reset()
+			literal.WriteString(value[:len(value)-trailing])
+		}
+		flush()
+		if len(production) == 0 {
+			return errors.New("empty alternative")
+		}
+		productions = append(productions, production)
+		production = nil
+		return nil
+	}
+
+	for i := 0; i < len(text); i++ {
+		switch text[i] {
+		case '|':
+			if err := end(); err != nil {
+				return nil, err
+			}
+		case '"':
+			closing := strings.IndexByte(text[i+1:], '"')
+			if closing < 0 {
+				return nil, errors.New("unterminated quote")
+			}
+			literal.WriteString(text[i+1 : i+1+closing])
+			trailing = 0
+			i += closing + 1
+		case '<':
+			closing := strings.IndexByte(text[i:], '>')
+			if closing < 0 || !isNonTerminal(text[i:i+closing+1]) {
+				literal.WriteByte(text[i])
+				trailing = 0
+				continue
+			}
+			flush()
+			production = append(production, Symbol{Value: text[i+1 : i+closing]})
+			i += closing
+		default:
+			if isSpace(rune(text[i])) {
+				if len(production) == 0 && literal.Len() == 0 {
+					continue
+				}
+				trailing++
+			} else {
+				trailing = 0
+			}
+			literal.WriteByte(text[i])
+		}
+	}
+	if err := end(); err != nil {
+		return nil, err
+	}
+	return productions, nil
+}
+
+// isSpace reports whether r is a space or tab
+func isSpace(r rune) bool {
+	return r == ' ' || r == '\t'
+}
+
+// Validate returns an error if the grammar has no start symbol, refers to an undefined non-terminal,
+// or has a non-terminal from which no derivation can finish
+func (grammar *Grammar) Validate() error {
+	if _, ok := grammar.Rules[grammar.Start]; !ok {
+		return fmt.Errorf("start symbol <%v> is not defined", grammar.Start)
+	}
+	for name, productions := range grammar.Rules {
+		if len(productions) == 0 {
+			return fmt.Errorf("rule <%v> has no alternatives", name)
+		}
+		for _, production := range productions {
+			for _, symbol := range production {
+				if _, ok := grammar.Rules[symbol.Value]; !symbol.Terminal && !ok {
+					return fmt.Errorf("rule <%v> refers to undefined <%v>", name, symbol.Value)
+				}
+			}
+		}
+	}
+
+	// A non-terminal finishes if one of its productions only contains terminals and non-terminals that finish
+	finishes := make(map[string]bool)
+	for changed := true; changed; {
+		changed = false
+		for name, productions := range grammar.Rules {
+			if finishes[name] {
+				continue
+			}
+			for _, production := range productions {
+				if grammar.finishes(production, finishes) {
+					finishes[name] = true
+					changed = true
+					break
+				}
+			}
+		}
+	}
+	for name := range grammar.Rules {
+		if !finishes[name] {
+			return fmt.Errorf("rule <%v> can never finish deriving", name)
+		}
+	}
+	return nil
+}
+
+// finishes reports whether every non-terminal of production is known to finish
+func (grammar *Grammar) finishes(production Production, finishes map[string]bool) bool {
+	for _, symbol := range production {
+		if !symbol.Terminal && !finishes[symbol.Value] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,88 @@
+package ge
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestParseGrammar(t *testing.T) {
+	t.Parallel()
+	grammar, err := ParseGrammar(strings.NewReader(`
+// Comparisons between inputs and constants
+<condition> ::= <input> <op> <const>
+              | "(" <condition> " | " <condition> ")"
+<input> ::= x0 | x1
+<op> ::= < | <= | ">"
+<const> ::= 0.<digit><digit>
+<digit> ::= 0 | 5
+`))
+	if err != nil {
+		t.Fatal("Parse errored unexpectedly. Got:", err)
+	}
+	if grammar.Start != "condition" {
+		t.Error("Incorrect start symbol.", "Expected:", "condition", "Got:", grammar.Start)
+	}
+	tests := map[string]string{
+		"condition": "[[{input false} {  true} {op false} {  true} {const false}] [{(  true} {condition false} {  |   true} {condition false} { ) true}]]",
+		"op":        "[[{< true}] [{<= true}] [{> true}]]",
+		"const":     "[[{0. true} {digit false} {digit false}]]",
+	}
+	for name, expected := range tests {
+		if got := fmt.Sprint(grammar.Rules[name]); got != expected {
+			t.Error("Incorrect productions for", name, "Expected:", expected, "Got:", got)
+		}
+	}
+}
+
+func TestParseGrammar_QuotedSpace(t *testing.T) {
+	t.Parallel()
+	grammar, err := ParseGrammar(strings.NewReader(`
+<list> ::= x <sep> x
+<sep> ::= " " | "," |  " + "  | " "y
+`))
+	if err != nil {
+		t.Fatal("Parse errored unexpectedly. Got:", err)
+	}
+	expected := "[[{  true}] [{, true}] [{ +  true}] [{ y true}]]"
+	if got := fmt.Sprint(grammar.Rules["sep"]); got != expected {
+		t.Error("Quoted whitespace not kept.", "Expected:", expected, "Got:", got)
+	} else {
+		t.Log("Quoted whitespace kept.", "Got:", got)
+	}
+}
+
+func TestParseGrammar_Errors(t *testing.T) {
+	t.Parallel()
+	tests := map[string]string{
+		"empty":              ``,
+		"no rule":            `x ::= y`,
+		"continuation first": `| x`,
+		"undefined":          `<a> ::= <b>`,
+		"never finishes":     "<a> ::= <b> | x\n<b> ::= <b> y",
+		"defined twice":      "<a> ::= x\n<a> ::= y",
+		"empty alternative":  `<a> ::= x | | y`,
+		"unterminated quote": `<a> ::= "x`,
+	}
+	for name, text := range tests {
+		if _, err := ParseGrammar(strings.NewReader(text)); err == nil {
+			t.Error("Parse accepted an invalid grammar:", name)
+		} else {
+			t.Log("Parse rejected an invalid grammar:", name, "Got:", err)
+		}
+	}
+}
+
+func TestLoadGrammar(t *testing.T) {
+	t.Parallel()
+	grammar, err := LoadGrammar("../data/conditions.bnf")
+	if err != nil {
+		t.Fatal("Load errored unexpectedly. Got:", err)
+	}
+	if grammar.Start != "condition" || len(grammar.Rules["compound"]) != 5 || len(grammar.Rules["input"]) != 6 {
+		t.Error("Incorrect grammar loaded. Got:", grammar)
+	}
+	if _, err := LoadGrammar("../data/missing.bnf"); err == nil {
+		t.Error("Loaded a grammar that does not exist")
+	}
+}
@@ -0,0 +1,83 @@
+package ge
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	ga "github.com/Sub-Xaero/GoGeneticAlgorithm"
+)
+
+// ErrIncompleteDerivation is returned by Map when a genome runs out of codons, after wrapping,
+// before every non-terminal has been expanded
+var ErrIncompleteDerivation = errors.New("incomplete derivation")
+
+// CodonAlphabet is the range of each codon of a grammatical evolution genome
+var CodonAlphabet = ga.IntRange{Lower: 0, Upper: 255}
+
+// Map derives a phenotype from genome, starting at the grammar's Start symbol and always expanding the leftmost
+// non-terminal. Each choice between several productions consumes a codon, selecting the production at the codon
+// modulo the number of productions. When codons run out the genome is reread from the start, up to maxWraps times,
+// after which Map returns an error wrapping ErrIncompleteDerivation. The grammar must be valid
+func (grammar *Grammar) Map(genome []int, maxWraps int) (string, error) {
+	if len(genome) == 0 {
+		return "", fmt.Errorf("%w: genome is empty", ErrIncompleteDerivation)
+	}
+	var builder strings.Builder
+	// stack holds the symbols still to derive, with the leftmost last
+	stack := []Symbol{{Value: grammar.Start}}
+	codon, wraps := 0, 0
+	for len(stack) > 0 {
+		symbol := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		if symbol.Terminal {
+			builder.WriteString(symbol.Value)
+			continue
+		}
+		productions, ok := grammar.Rules[symbol.Value]
+		if !ok {
+			return "", fmt.Errorf("rule <%v> is not defined", symbol.Value)
+		}
+		choice := 0
+		if len(productions) > 1 {
+			if codon == len(genome) {
+				if wraps == maxWraps {
+					return "", fmt.Errorf("%w after %v wraps", ErrIncompleteDerivation, wraps)
+				}
+				codon = 0
+				wraps++
+			}
+			choice = genome[codon] % len(productions)
+			if choice < 0 {
+				choice += len(productions)
+			}
+			codon++
+		}
+		production := productions[choice]
+		for i := len(production) - 1; i >= 0; i-- {
+			stack = append(stack, production[i])
+		}
+	}
+	return builder.String(), nil
+}
+
+// Fitness returns a fitness function that maps genomes through grammar with up to maxWraps wraps, and scores the
+// phenotype with f. Genomes whose derivation is incomplete score penalty
+func Fitness(grammar *Grammar, maxWraps int, penalty float64, f func(phenotype string) float64) ga.FitnessFunctionOf[int] {
+	return func(gene ga.GenomeOf[int]) float64 {
+		phenotype, err := grammar.Map(gene.Sequence, maxWraps)
+		if err != nil {
+			return penalty
+		}
+		return f(phenotype)
+	}
+}
+
+// NewGeneticAlgorithm returns a GA over genomes of length codons drawn from CodonAlphabet, using one-point
+// crossover, uniform codon mutation and tournament selection. The fitness function, usually from Fitness,
+// must be set before running it, and runs must use the same length
+func NewGeneticAlgorithm(length int) ga.GeneticAlgorithmOf[int] {
+	geneticAlgorithm := ga.NewAlphabetGeneticAlgorithm[int](ga.UniformAlphabets[int](length, CodonAlphabet))
+	geneticAlgorithm.SetCrossoverFunc(ga.OnePointCrossover[int])
+	return geneticAlgorithm
+}
@@ -0,0 +1,154 @@
+package ge
+
+import (
+	"bufio"
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	ga "github.com/Sub-Xaero/GoGeneticAlgorithm"
+)
+
+func mustParse(t *testing.T, text string) *Grammar {
+	grammar, err := ParseGrammar(strings.NewReader(text))
+	if err != nil {
+		t.Fatal("Parse errored unexpectedly. Got:", err)
+	}
+	return grammar
+}
+
+func TestMap(t *testing.T) {
+	t.Parallel()
+	expression := mustParse(t, "<e> ::= <e> + <e> | x | y")
+	digits := mustParse(t, "<s> ::= <d><d><d>\n<d> ::= 0 | 1")
+	tests := []struct {
+		grammar  *Grammar
+		genome   []int
+		maxWraps int
+		expected string
+	}{
+		{expression, []int{0, 1, 2}, 0, "x + y"},
+		{expression, []int{3, 7, 8}, 0, "x + y"},
+		{expression, []int{-1, 2}, 0, "y"},
+		{expression, []int{0, 0, 1, 2, 2}, 0, "x + y + y"},
+		{digits, []int{1, 0}, 1, "101"},
+		{digits, []int{1}, 2, "111"},
+	}
+	for _, test := range tests {
+		got, err := test.grammar.Map(test.genome, test.maxWraps)
+		if err != nil {
+			t.Error("Map errored unexpectedly.", "Genome:", test.genome, "Got:", err)
+		} else if got != test.expected {
+			t.Error("Incorrect phenotype.", "Genome:", test.genome, "Expected:", test.expected, "Got:", got)
+		}
+	}
+}
+
+func TestMap_Incomplete(t *testing.T) {
+	t.Parallel()
+	expression := mustParse(t, "<e> ::= <e> + <e> | x | y")
+	digits := mustParse(t, "<s> ::= <d><d><d>\n<d> ::= 0 | 1")
+	tests := []struct {
+		grammar  *Grammar
+		genome   []int
+		maxWraps int
+	}{
+		{expression, []int{0}, 10},
+		{expression, []int{0, 1}, 0},
+		{digits, []int{1, 0}, 0},
+		{digits, []int{1}, 1},
+		{digits, []int{}, 5},
+	}
+	for _, test := range tests {
+		if got, err := test.grammar.Map(test.genome, test.maxWraps); !errors.Is(err, ErrIncompleteDerivation) {
+			t.Error("Map did not report an incomplete derivation.", "Genome:", test.genome, "Wraps:", test.maxWraps, "Got:", got, err)
+		}
+	}
+}
+
+// evaluateCondition evaluates a prefix condition from data/conditions.bnf on inputs
+func evaluateCondition(tokens []string, inputs string) (bool, []string) {
+	token, rest := tokens[0], tokens[1:]
+	switch token {
+	case "and", "or", "xor":
+		a, rest := evaluateCondition(rest, inputs)
+		b, rest := evaluateCondition(rest, inputs)
+		switch token {
+		case "and":
+			return a && b, rest
+		case "or":
+			return a || b, rest
+		}
+		return a != b, rest
+	case "not":
+		a, rest := evaluateCondition(rest, inputs)
+		return !a, rest
+	case "if":
+		c, rest := evaluateCondition(rest, inputs)
+		a, rest := evaluateCondition(rest, inputs)
+		b, rest := evaluateCondition(rest, inputs)
+		if c {
+			return a, rest
+		}
+		return b, rest
+	}
+	return inputs[token[1]-'0'] == '1', rest
+}
+
+func TestGrammaticalEvolution(t *testing.T) {
+	t.Parallel()
+	grammar, err := LoadGrammar("../data/conditions.bnf")
+	if err != nil {
+		t.Fatal("Load errored unexpectedly. Got:", err)
+	}
+	file, err := os.Open("../data/data2.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	var inputs []string
+	var outputs []bool
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 || len(fields[0]) != 6 {
+			continue
+		}
+		inputs = append(inputs, fields[0])
+		outputs = append(outputs, fields[1] == "1")
+	}
+
+	maxWraps := 2
+	length := 60
+	var geneticAlgorithm = NewGeneticAlgorithm(length)
+	geneticAlgorithm.SetSeed(3)
+	geneticAlgorithm.SetOutputFunc(func(a ...interface{}) {})
+	geneticAlgorithm.SetFitnessFunc(Fitness(grammar, maxWraps, -1, func(phenotype string) float64 {
+		tokens := strings.Fields(phenotype)
+		correct := 0
+		for i, val := range inputs {
+			if matches, _ := evaluateCondition(tokens, val); matches == outputs[i] {
+				correct++
+			}
+		}
+		return float64(correct)
+	}))
+
+	config := ga.NewRunConfig(300, length, 200)
+	config.EliteCount = 2
+	if err := geneticAlgorithm.RunWithConfig(config); err != nil {
+		t.Fatal("GA errored unexpectedly. Got:", err)
+	}
+	best := geneticAlgorithm.BestCandidate
+	phenotype, err := grammar.Map(best.Sequence, maxWraps)
+	if err != nil {
+		t.Fatal("Best candidate did not map. Got:", err)
+	}
+	expectedFitness := 52.0
+	if best.Fitness < expectedFitness {
+		t.Error("GA did not produce a suitable condition.", "Expected at least:", expectedFitness, "Got:", best.Fitness, phenotype)
+	} else {
+		t.Log("GA produced a suitable condition.", "Expected at least:", expectedFitness, "Got:", best.Fitness, phenotype)
+	}
+}
@@ -2,19 +2,50 @@ package ga
 
 import "fmt"
 
-// Genome represents a bitstring and associated fitness value
-type Genome struct {
-	Sequence Bitstring
+// Sequence is the encoding of a candidate solution, one gene per element
+type Sequence[T comparable] []T
+
+func (sequence Sequence[T]) String() string {
+	output := ""
+	for _, val := range sequence {
+		output += fmt.Sprintf("%v", val) + " "
+	}
+	return "[" + output + "]"
+}
+
+// GenomeOf represents a sequence of genes and associated fitness value. Fitness is only meaningful once Evaluated is set
+type GenomeOf[T comparable] struct {
+	Sequence  Sequence[T]
+	Fitness   float64
+	Evaluated bool
 }
 
-type Population [] Genome
+// Genome is a genome of bitstring genes
+type Genome = GenomeOf[string]
+
+type PopulationOf[T comparable] []GenomeOf[T]
 
-func (gene Genome) Copy() Genome {
-	sequence := make(Bitstring, len(gene.Sequence))
+// Population is a population of bitstring genomes
+type Population = PopulationOf[string]
+
+// Copy returns a copy of the genome's sequence, marked as not yet evaluated
+func (gene GenomeOf[T]) Copy() GenomeOf[T] {
+	sequence := make(Sequence[T], len(gene.Sequence))
 	copy(sequence, gene.Sequence)
-	return Genome{sequence}
+	return GenomeOf[T]{Sequence: sequence}
+}
+
+// duplicate returns a copy of the genome that keeps its fitness
+func (gene GenomeOf[T]) duplicate() GenomeOf[T] {
+	duplicate := gene.Copy()
+	duplicate.Fitness = gene.Fitness
+	duplicate.Evaluated = gene.Evaluated
+	return duplicate
 }
 
-func (gene Genome) String() string {
+func (gene GenomeOf[T]) String() string {
+	if gene.Evaluated {
+		return fmt.Sprintf("{%v %v}", gene.Sequence, gene.Fitness)
+	}
 	return fmt.Sprintf("{%v}", gene.Sequence)
 }
@@ -10,37 +10,99 @@ func TestGenome_ToString(t *testing.T) {
 	genA.SetSeed(3)
 	genA.SetOutputFunc(func(a ...interface{}) { t.Log(a...) })
 
-	genA.SetFitnessFunc(func(gene Genome) int {
+	genA.SetFitnessFunc(func(gene Genome) float64 {
 		count := 0
 		for _, i := range gene.Sequence {
 			if i == "1" {
 				count++
 			}
 		}
-		return count
+		return float64(count)
 	})
 
-	outputString := Genome{Bitstring{"1", "1", "1", "1"}}.String()
+	outputString := Genome{Sequence: Bitstring{"1", "1", "1", "1"}}.String()
 	expected := "{[1 1 1 1 ]}"
 	if outputString != expected {
 		t.Error("Incorrect string:", outputString, "Expected:", expected)
 	}
 
-	outputString = Genome{Bitstring{"1", "0", "1", "0", "1", "0", "1", "0", "1", "0"}}.String()
+	outputString = Genome{Sequence: Bitstring{"1", "0", "1", "0", "1", "0", "1", "0", "1", "0"}}.String()
 	expected = "{[1 0 1 0 1 0 1 0 1 0 ]}"
 	if outputString != expected {
 		t.Error("Incorrect string:", outputString, "Expected:", expected)
 	}
 
-	outputString = Genome{Bitstring{"1", "1", "1", "1", "1", "1", "1", "1", "1", "1"}}.String()
+	outputString = Genome{Sequence: Bitstring{"1", "1", "1", "1", "1", "1", "1", "1", "1", "1"}}.String()
 	expected = "{[1 1 1 1 1 1 1 1 1 1 ]}"
 	if outputString != expected {
 		t.Error("Incorrect string:", outputString, "Expected:", expected)
 	}
 
-	outputString = Genome{Bitstring{"1", "1", "1", "1", "1", "1", "1", "1", "1", "1", "1", "1"}}.String()
+	outputString = Genome{Sequence: Bitstring{"1", "1", "1", "1", "1", "1", "1", "1", "1", "1", "1", "1"}}.String()
 	expected = "{[1 1 1 1 1 1 1 1 1 1 1 1 ]}"
 	if outputString != expected {
 		t.Error("Incorrect string:", outputString, "Expected:", expected)
 	}
 }
+
+func TestGenome_Copy(t *testing.T) {
+	t.Parallel()
+	gene := Genome{Sequence: Bitstring{"1", "0"}, Fitness: 1, Evaluated: true}
+
+	copied := gene.Copy()
+	if copied.Evaluated {
+		t.Error("Copy kept evaluated flag. Got:", copied)
+	}
+	copied.Sequence[0] = "0"
+	if gene.Sequence[0] != "1" {
+		t.Error("Copy shares memory with the original genome.")
+	}
+
+	duplicate := gene.duplicate()
+	if !duplicate.Evaluated || duplicate.Fitness != gene.Fitness {
+		t.Error("Duplicate lost fitness.", "Expected:", gene, "Got:", duplicate)
+	}
+}
+
+func TestGenome_StringWithFitness(t *testing.T) {
+	t.Parallel()
+	outputString := Genome{Sequence: Bitstring{"1", "1", "0", "1"}, Fitness: 3, Evaluated: true}.String()
+	expected := "{[1 1 0 1 ] 3}"
+	if outputString != expected {
+		t.Error("Incorrect string:", outputString, "Expected:", expected)
+	}
+}
+
+func TestGenome_OperatorsResetFitness(t *testing.T) {
+	t.Parallel()
+	var genA = NewGeneticAlgorithm()
+	genA.SetSeed(3)
+	gene := Genome{Sequence: Bitstring{"1", "1", "0", "1"}, Fitness: 3, Evaluated: true}
+	spouse := Genome{Sequence: Bitstring{"0", "0", "0", "1"}, Fitness: 1, Evaluated: true}
+
+	if mutated := genA.Mutate(gene, genA.RandomEngine); mutated.Evaluated {
+		t.Error("Mutated child marked as evaluated. Got:", mutated)
+	}
+	offspring, _ := genA.Crossover(gene, spouse, genA.RandomEngine)
+	for _, val := range offspring {
+		if val.Evaluated {
+			t.Error("Crossover child marked as evaluated. Got:", val)
+		}
+	}
+}
+
+func TestGenomeOf_String(t *testing.T) {
+	t.Parallel()
+	gene := GenomeOf[float64]{Sequence: Sequence[float64]{0.5, -1}}
+	expected := "{[0.5 -1 ]}"
+	if gene.String() != expected {
+		t.Error("Incorrect string.", "Expected:", expected, "Got:", gene.String())
+	}
+	gene.Fitness, gene.Evaluated = 2, true
+	expected = "{[0.5 -1 ] 2}"
+	if gene.String() != expected {
+		t.Error("Incorrect string.", "Expected:", expected, "Got:", gene.String())
+	} else {
+		t.Log("Correct string.", "Expected:", expected, "Got:", gene.String())
+	}
+}
@@ -0,0 +1,169 @@
+package gp
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+
+	ga "github.com/Sub-Xaero/GoGeneticAlgorithm"
+)
+
+// generate returns a random tree returning typ, no deeper than maxDepth. Full trees place functions at every
+// depth above maxDepth where the type allows, while grown trees choose uniformly between functions and terminals
+func (set *PrimitiveSet[V]) generate(typ Type, maxDepth int, full bool, random *rand.Rand) Tree[V] {
+	var tree Tree[V]
+	var build func(typ Type, depth int)
+	build = func(typ Type, depth int) {
+		functions, terminals := set.functions[typ], set.terminals[typ]
+		var index int
+		switch {
+		case depth >= maxDepth || len(functions) == 0:
+			index = terminals[random.Intn(len(terminals))]
+		case full:
+			index = functions[random.Intn(len(functions))]
+		default:
+			if choice := random.Intn(len(functions) + len(terminals)); choice < len(functions) {
+				index = functions[choice]
+			} else {
+				index = terminals[choice-len(functions)]
+			}
+		}
+		tree = append(tree, set.node(index, random))
+		for _, arg := range set.Primitives[index].Args {
+			build(arg, depth+1)
+		}
+	}
+	build(typ, 0)
+	return tree
+}
+
+// RampedHalfAndHalf returns a generator that draws a depth from minDepth to maxDepth, and whether to build a full
+// or a grown tree, from random, so a population gets an even spread of shapes and sizes.
+// The length requested by Run is ignored
+func RampedHalfAndHalf[V comparable](set *PrimitiveSet[V], minDepth, maxDepth int) ga.GenerateCandidateFunctionOf[Node[V]] {
+	return func(length int, random *rand.Rand) (Tree[V], error) {
+		if minDepth < 0 || maxDepth < minDepth {
+			return nil, fmt.Errorf("depths %v to %v are not a valid range", minDepth, maxDepth)
+		}
+		depth := minDepth + random.Intn(maxDepth-minDepth+1)
+		full := random.Intn(2) == 0
+		return set.generate(set.Root, depth, full, random), nil
+	}
+}
+
+// SubtreeCrossover returns a crossover that swaps a random subtree of each parent for a random subtree of the
+// other returning the same Type. A child deeper than maxDepth is replaced by a copy of its parent
+func SubtreeCrossover[V comparable](set *PrimitiveSet[V], maxDepth int) ga.CrossoverFunctionOf[Node[V]] {
+	return func(gene, spouse ga.GenomeOf[Node[V]], random *rand.Rand) (ga.PopulationOf[Node[V]], error) {
+		if err := set.Validate(gene.Sequence); err != nil {
+			return nil, err
+		}
+		if err := set.Validate(spouse.Sequence); err != nil {
+			return nil, err
+		}
+		a := random.Intn(len(gene.Sequence))
+		typ := set.primitive(gene.Sequence[a]).Return
+		var points []int
+		for i, node := range spouse.Sequence {
+			if set.primitive(node).Return == typ {
+				points = append(points, i)
+			}
+		}
+		if len(points) == 0 {
+			return ga.PopulationOf[Node[V]]{gene.Copy(), spouse.Copy()}, nil
+		}
+		b := points[random.Intn(len(points))]
+		aEnd, bEnd := set.subtreeEnd(gene.Sequence, a), set.subtreeEnd(spouse.Sequence, b)
+
+		child := func(parent Tree[V], start, end int, subtree Tree[V]) ga.GenomeOf[Node[V]] {
+			tree := make(Tree[V], 0, len(parent)-(end-start)+len(subtree))
+			tree = append(append(append(tree, parent[:start]...), subtree...), parent[end:]...)
+			if set.Depth(tree) > maxDepth {
+				return ga.GenomeOf[Node[V]]{Sequence: slices.Clone(parent)}
+			}
+			return ga.GenomeOf[Node[V]]{Sequence: tree}
+		}
+		return ga.PopulationOf[Node[V]]{
+			child(gene.Sequence, a, aEnd, spouse.Sequence[b:bEnd]),
+			child(spouse.Sequence, b, bEnd, gene.Sequence[a:aEnd]),
+		}, nil
+	}
+}
+
+// SubtreeMutation returns a mutate function that replaces a random subtree with a grown tree of the same Type,
+// no deeper than subtreeDepth, and limited so the whole tree stays within maxDepth
+func SubtreeMutation[V comparable](set *PrimitiveSet[V], maxDepth, subtreeDepth int) ga.MutateErrFunctionOf[Node[V]] {
+	return func(gene ga.GenomeOf[Node[V]], random *rand.Rand) (ga.GenomeOf[Node[V]], error) {
+		if err := set.Validate(gene.Sequence); err != nil {
+			return ga.GenomeOf[Node[V]]{}, err
+		}
+		point := random.Intn(len(gene.Sequence))
+		depth := min(subtreeDepth, maxDepth-set.depths(gene.Sequence)[point])
+		subtree := set.generate(set.primitive(gene.Sequence[point]).Return, max(0, depth), false, random)
+		end := set.subtreeEnd(gene.Sequence, point)
+		tree := make(Tree[V], 0, len(gene.Sequence)-(end-point)+len(subtree))
+		tree = append(append(append(tree, gene.Sequence[:point]...), subtree...), gene.Sequence[end:]...)
+		return ga.GenomeOf[Node[V]]{Sequence: tree}, nil
+	}
+}
+
+// PointMutation returns a mutate function that replaces each node, with the given probability or one over the
+// size of the tree if it is not positive, by a random primitive with the same signature. Constants are redrawn
+func PointMutation[V comparable](set *PrimitiveSet[V], probability float64) ga.MutateErrFunctionOf[Node[V]] {
+	return func(gene ga.GenomeOf[Node[V]], random *rand.Rand) (ga.GenomeOf[Node[V]], error) {
+		if err := set.Validate(gene.Sequence); err != nil {
+			return ga.GenomeOf[Node[V]]{}, err
+		}
+		rate := probability
+		if rate <= 0 {
+			rate = 1 / float64(len(gene.Sequence))
+		}
+		gene = gene.Copy()
+		for i, node := range gene.Sequence {
+			if random.Float64() >= rate {
+				continue
+			}
+			current := set.primitive(node)
+			var alternatives []int
+			for j, val := range set.Primitives {
+				if val.Return == current.Return && slices.Equal(val.Args, current.Args) {
+					alternatives = append(alternatives, j)
+				}
+			}
+			gene.Sequence[i] = set.node(alternatives[random.Intn(len(alternatives))], random)
+		}
+		return gene, nil
+	}
+}
+
+// HoistMutation returns a mutate function that replaces a tree with one of its own proper subtrees returning
+// Root, chosen at random. Trees without one are returned unchanged
+func HoistMutation[V comparable](set *PrimitiveSet[V]) ga.MutateErrFunctionOf[Node[V]] {
+	return func(gene ga.GenomeOf[Node[V]], random *rand.Rand) (ga.GenomeOf[Node[V]], error) {
+		if err := set.Validate(gene.Sequence); err != nil {
+			return ga.GenomeOf[Node[V]]{}, err
+		}
+		var points []int
+		for i, node := range gene.Sequence[1:] {
+			if set.primitive(node).Return == set.Root {
+				points = append(points, i+1)
+			}
+		}
+		if len(points) == 0 {
+			return gene.Copy(), nil
+		}
+		point := points[random.Intn(len(points))]
+		return ga.GenomeOf[Node[V]]{Sequence: slices.Clone(gene.Sequence[point:set.subtreeEnd(gene.Sequence, point)])}, nil
+	}
+}
+
+// NewGeneticAlgorithm returns a GA over trees built from set, using ramped half-and-half initialisation between
+// minDepth and maxDepth, subtree crossover, subtree mutation with subtrees up to depth 4 and tournament selection.
+// Crossover and mutation keep trees within maxDepth. The fitness function must be set before running it
+func NewGeneticAlgorithm[V comparable](set *PrimitiveSet[V], minDepth, maxDepth int) ga.GeneticAlgorithmOf[Node[V]] {
+	geneticAlgorithm := ga.NewGeneticAlgorithmOf[Node[V]]()
+	geneticAlgorithm.SetGenerateCandidate(RampedHalfAndHalf(set, minDepth, maxDepth))
+	geneticAlgorithm.SetCrossoverFunc(SubtreeCrossover(set, maxDepth))
+	geneticAlgorithm.SetMutateErrFunc(SubtreeMutation(set, maxDepth, 4))
+	return geneticAlgorithm
+}
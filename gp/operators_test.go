@@ -0,0 +1,205 @@
+package gp
+
+import (
+	"bufio"
+	"fmt"
+	"math/rand"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	ga "github.com/Sub-Xaero/GoGeneticAlgorithm"
+)
+
+func TestRampedHalfAndHalf(t *testing.T) {
+	t.Parallel()
+	set := typedSet(t)
+	generate := RampedHalfAndHalf(set, 1, 4)
+	trees := func(seed int64) []string {
+		random := rand.New(rand.NewSource(seed))
+		var formatted []string
+		for i := 0; i < 5; i++ {
+			tree, err := generate(0, random)
+			if err != nil {
+				t.Fatal("Generator errored unexpectedly. Got:", err)
+			}
+			formatted = append(formatted, set.Format(tree))
+		}
+		return formatted
+	}
+
+	random := rand.New(rand.NewSource(3))
+	depths := make(map[int]bool)
+	for i := 0; i < 40; i++ {
+		tree, err := generate(0, random)
+		if err != nil {
+			t.Fatal("Generator errored unexpectedly. Got:", err)
+		}
+		if err := set.Validate(tree); err != nil {
+			t.Fatal("Generated an invalid tree.", "Got:", err)
+		}
+		depth := set.Depth(tree)
+		if depth > 4 {
+			t.Fatal("Generated a tree deeper than the limit.", "Expected at most:", 4, "Got:", depth)
+		}
+		depths[depth] = true
+	}
+	for depth := 1; depth <= 4; depth++ {
+		if !depths[depth] {
+			t.Error("Generator did not ramp depths.", "Expected:", depth, "Got:", depths)
+		}
+	}
+	// The generator keeps no state of its own, so the same random sequence gives the same trees
+	if first, second := trees(5), trees(5); fmt.Sprint(first) != fmt.Sprint(second) {
+		t.Error("Generator depends on earlier calls.", "Expected:", first, "Got:", second)
+	}
+	if _, err := RampedHalfAndHalf(set, 3, 2)(0, random); err == nil {
+		t.Error("Generator accepted an invalid depth range")
+	}
+}
+
+func TestOperatorsStayValid(t *testing.T) {
+	t.Parallel()
+	set := typedSet(t)
+	maxDepth := 6
+	generate := RampedHalfAndHalf(set, 1, maxDepth)
+	crossover := SubtreeCrossover(set, maxDepth)
+	mutations := map[string]ga.MutateErrFunctionOf[Node[float64]]{
+		"Subtree": SubtreeMutation(set, maxDepth, 3),
+		"Point":   PointMutation(set, 0.3),
+		"Hoist":   HoistMutation(set),
+	}
+	random := rand.New(rand.NewSource(3))
+	check := func(operator string, tree Tree[float64]) {
+		if err := set.Validate(tree); err != nil {
+			t.Fatal(operator, "produced an invalid tree.", "Got:", err)
+		}
+		if depth := set.Depth(tree); depth > maxDepth {
+			t.Fatal(operator, "exceeded the depth limit.", "Expected at most:", maxDepth, "Got:", depth)
+		}
+	}
+	for i := 0; i < 300; i++ {
+		gene, _ := generate(0, random)
+		spouse, _ := generate(0, random)
+		children, err := crossover(ga.GenomeOf[Node[float64]]{Sequence: gene}, ga.GenomeOf[Node[float64]]{Sequence: spouse}, random)
+		if err != nil {
+			t.Fatal("Crossover errored unexpectedly. Got:", err)
+		}
+		for _, child := range children {
+			check("Crossover", child.Sequence)
+		}
+		for name, mutate := range mutations {
+			mutated, err := mutate(ga.GenomeOf[Node[float64]]{Sequence: gene}, random)
+			if err != nil {
+				t.Fatal(name, "errored unexpectedly. Got:", err)
+			}
+			check(name, mutated.Sequence)
+		}
+	}
+
+	bad := ga.GenomeOf[Node[float64]]{Sequence: Tree[float64]{{Primitive: 0}}}
+	if _, err := crossover(bad, bad, random); err == nil {
+		t.Error("Crossover accepted an invalid tree")
+	}
+	for name, mutate := range mutations {
+		if _, err := mutate(bad, random); err == nil {
+			t.Error(name, "accepted an invalid tree")
+		}
+	}
+}
+
+func TestHoistMutation_Shrinks(t *testing.T) {
+	t.Parallel()
+	set := typedSet(t)
+	random := rand.New(rand.NewSource(3))
+	// (add x0 (mul x1 x1))
+	gene := ga.GenomeOf[Node[float64]]{Sequence: Tree[float64]{{Primitive: 0}, {Primitive: 6}, {Primitive: 1}, {Primitive: 7}, {Primitive: 7}}}
+	hoisted, err := HoistMutation(set)(gene, random)
+	if err != nil {
+		t.Fatal("Mutation errored unexpectedly. Got:", err)
+	}
+	expected := map[string]bool{"x0": true, "(mul x1 x1)": true, "x1": true}
+	if got := set.Format(hoisted.Sequence); !expected[got] {
+		t.Error("Hoist did not return a subtree.", "Expected one of:", expected, "Got:", got)
+	}
+}
+
+func TestSymbolicRegression(t *testing.T) {
+	t.Parallel()
+	file, err := os.Open("../data/data3.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	var inputs [][]float64
+	var targets []float64
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() && len(inputs) < 200 {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 7 {
+			continue
+		}
+		row := make([]float64, len(fields))
+		for i, val := range fields {
+			if row[i], err = strconv.ParseFloat(val, 64); err != nil {
+				break
+			}
+		}
+		if err != nil {
+			continue
+		}
+		inputs = append(inputs, row[:6])
+		targets = append(targets, row[6])
+	}
+	if len(inputs) != 200 {
+		t.Fatal("Incorrect number of rows loaded.", "Expected:", 200, "Got:", len(inputs))
+	}
+
+	mean := 0.0
+	for _, val := range targets {
+		mean += val / float64(len(targets))
+	}
+	baseline := 0.0
+	for _, val := range targets {
+		baseline += (val - mean) * (val - mean) / float64(len(targets))
+	}
+
+	set, err := ArithmeticSet(6)
+	if err != nil {
+		t.Fatal("Arithmetic set errored unexpectedly. Got:", err)
+	}
+	var geneticAlgorithm = NewGeneticAlgorithm(set, 2, 6)
+	geneticAlgorithm.SetSeed(3)
+	geneticAlgorithm.SetOutputFunc(func(a ...interface{}) {})
+	geneticAlgorithm.SetObjective(ga.Minimise)
+	geneticAlgorithm.SetParsimony(0.0005)
+	geneticAlgorithm.SetFitnessErrFunc(func(gene ga.GenomeOf[Node[float64]]) (float64, error) {
+		evaluate, err := set.Evaluator(gene.Sequence)
+		if err != nil {
+			return 0, err
+		}
+		squaredError := 0.0
+		for i, row := range inputs {
+			diff := evaluate(row) - targets[i]
+			squaredError += diff * diff
+		}
+		return squaredError / float64(len(inputs)), nil
+	})
+
+	config := ga.NewRunConfig(200, 1, 60)
+	config.EliteCount = 2
+	if err := geneticAlgorithm.RunWithConfig(config); err != nil {
+		t.Fatal("GA errored unexpectedly. Got:", err)
+	}
+	best := geneticAlgorithm.BestCandidate
+	expected := 0.9 * baseline
+	if got := geneticAlgorithm.Fitness(best); got > expected {
+		t.Error("GA did not improve on predicting the mean.", "Expected at most:", expected, "Got:", got)
+	} else {
+		t.Log("GA improved on predicting the mean.", "Expected at most:", expected, "Got:", got, "Tree:", set.Format(best.Sequence))
+	}
+	if depth := set.Depth(best.Sequence); depth > 6 {
+		t.Error("Best tree exceeded the depth limit.", "Expected at most:", 6, "Got:", depth)
+	}
+}
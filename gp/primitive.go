@@ -0,0 +1,141 @@
+// Package gp implements tree-based genetic programming, evolving typed expression trees stored in prefix order
+// as a ga.Sequence of Nodes
+package gp
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+)
+
+// Type names the type of value a primitive returns or accepts. Trees are only built and recombined so that every
+// argument has the Type its function expects
+type Type string
+
+// Float is the Type of the arithmetic primitives
+const Float Type = "float"
+
+// Primitive is a function, taking one argument per entry of Args, or a terminal, taking none
+type Primitive[V comparable] struct {
+	Name   string
+	Return Type
+	Args   []Type
+	// Eval returns the primitive's value given its arguments' values and the inputs the tree is evaluated on
+	Eval func(args []V, inputs []V) V
+	// Constant, if set, makes the terminal an ephemeral random constant. It is called once when a node is created,
+	// and the node evaluates to the value it returned
+	Constant func(random *rand.Rand) V
+}
+
+// Arity returns the number of arguments the primitive takes
+func (primitive Primitive[V]) Arity() int {
+	return len(primitive.Args)
+}
+
+// PrimitiveSet holds the function and terminal sets trees are built from
+type PrimitiveSet[V comparable] struct {
+	// Root is the Type returned by whole trees
+	Root       Type
+	Primitives []Primitive[V]
+
+	functions map[Type][]int
+	terminals map[Type][]int
+	maxArity  int
+}
+
+// NewPrimitiveSet returns a PrimitiveSet of trees returning root. Every Type returned by root or required by a
+// function's argument must have at least one terminal, so that trees can always be completed within a depth limit
+func NewPrimitiveSet[V comparable](root Type, primitives ...Primitive[V]) (*PrimitiveSet[V], error) {
+	set := &PrimitiveSet[V]{
+		Root:       root,
+		Primitives: primitives,
+		functions:  make(map[Type][]int),
+		terminals:  make(map[Type][]int),
+	}
+	for i, val := range primitives {
+		if val.Eval == nil && val.Constant == nil {
+			return nil, fmt.Errorf("primitive %v has no Eval func", val.Name)
+		}
+		if val.Constant != nil && val.Arity() > 0 {
+			return nil, fmt.Errorf("constant %v cannot take arguments", val.Name)
+		}
+		if val.Arity() == 0 {
+			set.terminals[val.Return] = append(set.terminals[val.Return], i)
+		} else {
+			set.functions[val.Return] = append(set.functions[val.Return], i)
+		}
+		set.maxArity = max(set.maxArity, val.Arity())
+	}
+	if len(set.terminals[root]) == 0 {
+		return nil, fmt.Errorf("no terminal returns root type %v", root)
+	}
+	for _, val := range primitives {
+		for _, arg := range val.Args {
+			if len(set.terminals[arg]) == 0 {
+				return nil, fmt.Errorf("no terminal returns type %v required by %v", arg, val.Name)
+			}
+		}
+	}
+	return set, nil
+}
+
+// primitive returns the Primitive a node refers to
+func (set *PrimitiveSet[V]) primitive(node Node[V]) Primitive[V] {
+	return set.Primitives[node.Primitive]
+}
+
+// node returns a new node of the primitive at index, drawing its value if it is an ephemeral constant
+func (set *PrimitiveSet[V]) node(index int, random *rand.Rand) Node[V] {
+	node := Node[V]{Primitive: index}
+	if constant := set.Primitives[index].Constant; constant != nil {
+		node.Value = constant(random)
+	}
+	return node
+}
+
+// Variable returns a terminal that evaluates to the input at index
+func Variable(name string, index int) Primitive[float64] {
+	return Primitive[float64]{Name: name, Return: Float, Eval: func(args []float64, inputs []float64) float64 {
+		return inputs[index]
+	}}
+}
+
+// EphemeralConstant returns a terminal whose nodes each hold a constant drawn uniformly between lower and upper
+func EphemeralConstant(lower, upper float64) Primitive[float64] {
+	return Primitive[float64]{Name: "const", Return: Float, Constant: func(random *rand.Rand) float64 {
+		return lower + random.Float64()*(upper-lower)
+	}}
+}
+
+// binary returns an arithmetic function of two Float arguments
+func binary(name string, f func(a, b float64) float64) Primitive[float64] {
+	return Primitive[float64]{Name: name, Return: Float, Args: []Type{Float, Float}, Eval: func(args []float64, inputs []float64) float64 {
+		return f(args[0], args[1])
+	}}
+}
+
+var (
+	Add      = binary("add", func(a, b float64) float64 { return a + b })
+	Subtract = binary("sub", func(a, b float64) float64 { return a - b })
+	Multiply = binary("mul", func(a, b float64) float64 { return a * b })
+	// Divide is protected division, returning 1 when the divisor is zero
+	Divide = binary("div", func(a, b float64) float64 {
+		if b == 0 {
+			return 1
+		}
+		return a / b
+	})
+)
+
+// ArithmeticSet returns a PrimitiveSet for symbolic regression over variables inputs, named x0, x1 and so on,
+// with add, sub, mul, protected div and ephemeral constants between -1 and 1
+func ArithmeticSet(variables int) (*PrimitiveSet[float64], error) {
+	if variables <= 0 {
+		return nil, errors.New("arithmetic set needs at least one variable")
+	}
+	primitives := []Primitive[float64]{Add, Subtract, Multiply, Divide, EphemeralConstant(-1, 1)}
+	for i := 0; i < variables; i++ {
+		primitives = append(primitives, Variable(fmt.Sprintf("x%v", i), i))
+	}
+	return NewPrimitiveSet(Float, primitives...)
+}
@@ -0,0 +1,81 @@
+package gp
+
+import (
+	"math/rand"
+	"testing"
+)
+
+// Bool is the Type of the comparison primitives in typedSet, with 1 for true and 0 for false
+const Bool Type = "bool"
+
+// typedSet returns a PrimitiveSet mixing Float and Bool, where conditions can only appear as the first argument of if
+func typedSet(t *testing.T) *PrimitiveSet[float64] {
+	truth := func(b bool) float64 {
+		if b {
+			return 1
+		}
+		return 0
+	}
+	set, err := NewPrimitiveSet(Float,
+		Add,
+		Multiply,
+		Primitive[float64]{Name: "if", Return: Float, Args: []Type{Bool, Float, Float}, Eval: func(args []float64, inputs []float64) float64 {
+			if args[0] != 0 {
+				return args[1]
+			}
+			return args[2]
+		}},
+		Primitive[float64]{Name: "gt", Return: Bool, Args: []Type{Float, Float}, Eval: func(args []float64, inputs []float64) float64 {
+			return truth(args[0] > args[1])
+		}},
+		Primitive[float64]{Name: "and", Return: Bool, Args: []Type{Bool, Bool}, Eval: func(args []float64, inputs []float64) float64 {
+			return truth(args[0] != 0 && args[1] != 0)
+		}},
+		Primitive[float64]{Name: "true", Return: Bool, Eval: func(args []float64, inputs []float64) float64 {
+			return 1
+		}},
+		Variable("x0", 0),
+		Variable("x1", 1),
+		EphemeralConstant(-1, 1),
+	)
+	if err != nil {
+		t.Fatal("Primitive set errored unexpectedly. Got:", err)
+	}
+	return set
+}
+
+func TestNewPrimitiveSet(t *testing.T) {
+	t.Parallel()
+	eval := func(args []float64, inputs []float64) float64 { return 0 }
+	tests := map[string][]Primitive[float64]{
+		"no root terminal":     {Add},
+		"no argument terminal": {Variable("x0", 0), {Name: "not", Return: Bool, Args: []Type{Bool}, Eval: eval}},
+		"constant with args":   {Variable("x0", 0), {Name: "c", Return: Float, Args: []Type{Float}, Constant: func(*rand.Rand) float64 { return 0 }}},
+		"no eval":              {Variable("x0", 0), {Name: "nop", Return: Float}},
+	}
+	for name, primitives := range tests {
+		if _, err := NewPrimitiveSet(Float, primitives...); err == nil {
+			t.Error("Primitive set accepted invalid primitives:", name)
+		}
+	}
+	if _, err := NewPrimitiveSet(Float, Add, Divide, Variable("x0", 0)); err != nil {
+		t.Error("Primitive set errored unexpectedly. Got:", err)
+	}
+}
+
+func TestArithmeticSet(t *testing.T) {
+	t.Parallel()
+	set, err := ArithmeticSet(3)
+	if err != nil {
+		t.Fatal("Arithmetic set errored unexpectedly. Got:", err)
+	}
+	if expected := 8; len(set.Primitives) != expected {
+		t.Error("Incorrect number of primitives.", "Expected:", expected, "Got:", len(set.Primitives))
+	}
+	if got := Divide.Eval([]float64{3, 0}, nil); got != 1 {
+		t.Error("Division by zero not protected.", "Expected:", 1, "Got:", got)
+	}
+	if _, err := ArithmeticSet(0); err == nil {
+		t.Error("Arithmetic set accepted zero variables")
+	}
+}
@@ -0,0 +1,150 @@
+package gp
+
+import (
+	"fmt"
+	"strings"
+
+	ga "github.com/Sub-Xaero/GoGeneticAlgorithm"
+)
+
+// Node is one primitive of a tree. Value holds the value of an ephemeral constant, and is otherwise zero
+type Node[V comparable] struct {
+	Primitive int
+	Value     V
+}
+
+// Tree is an expression tree in prefix order: each function is followed by the subtrees of its arguments
+type Tree[V comparable] = ga.Sequence[Node[V]]
+
+// subtreeEnd returns the index just past the subtree rooted at start
+func (set *PrimitiveSet[V]) subtreeEnd(tree Tree[V], start int) int {
+	need := 1
+	end := start
+	for ; need > 0; end++ {
+		need += set.primitive(tree[end]).Arity() - 1
+	}
+	return end
+}
+
+// depths returns the depth of every node of tree, with the root at depth 0
+func (set *PrimitiveSet[V]) depths(tree Tree[V]) []int {
+	depths := make([]int, len(tree))
+	// pending holds the depth of each argument still to be filled, with the next one last
+	pending := []int{0}
+	for i, node := range tree {
+		depths[i] = pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+		for j := 0; j < set.primitive(node).Arity(); j++ {
+			pending = append(pending, depths[i]+1)
+		}
+	}
+	return depths
+}
+
+// Depth returns the number of edges on the longest path from the root of tree to a terminal
+func (set *PrimitiveSet[V]) Depth(tree Tree[V]) int {
+	depth := 0
+	for _, val := range set.depths(tree) {
+		depth = max(depth, val)
+	}
+	return depth
+}
+
+// Validate returns an error unless tree is one complete tree of known primitives returning Root,
+// with every argument of the Type its function expects
+func (set *PrimitiveSet[V]) Validate(tree Tree[V]) error {
+	if len(tree) == 0 {
+		return fmt.Errorf("tree is empty")
+	}
+	// pending holds the Type of each argument still to be filled, with the next one last
+	pending := []Type{set.Root}
+	for i, node := range tree {
+		if len(pending) == 0 {
+			return fmt.Errorf("tree is complete before node %v", i)
+		}
+		if node.Primitive < 0 || node.Primitive >= len(set.Primitives) {
+			return fmt.Errorf("node %v refers to unknown primitive %v", i, node.Primitive)
+		}
+		primitive := set.primitive(node)
+		if expected := pending[len(pending)-1]; primitive.Return != expected {
+			return fmt.Errorf("node %v is %v returning %v, expected %v", i, primitive.Name, primitive.Return, expected)
+		}
+		pending = pending[:len(pending)-1]
+		for j := primitive.Arity() - 1; j >= 0; j-- {
+			pending = append(pending, primitive.Args[j])
+		}
+	}
+	if len(pending) > 0 {
+		return fmt.Errorf("tree is missing %v arguments", len(pending))
+	}
+	return nil
+}
+
+// Format returns tree as an S-expression, such as (add x0 (mul x1 0.5))
+func (set *PrimitiveSet[V]) Format(tree Tree[V]) string {
+	var builder strings.Builder
+	var format func(start int) int
+	format = func(start int) int {
+		primitive := set.primitive(tree[start])
+		switch {
+		case primitive.Constant != nil:
+			fmt.Fprint(&builder, tree[start].Value)
+			return start + 1
+		case primitive.Arity() == 0:
+			builder.WriteString(primitive.Name)
+			return start + 1
+		}
+		builder.WriteString("(" + primitive.Name)
+		next := start + 1
+		for range primitive.Args {
+			builder.WriteString(" ")
+			next = format(next)
+		}
+		builder.WriteString(")")
+		return next
+	}
+	if set.Validate(tree) != nil {
+		return fmt.Sprint(tree)
+	}
+	format(0)
+	return builder.String()
+}
+
+// Evaluate returns the value of tree given inputs. It returns an error if tree is not valid
+func (set *PrimitiveSet[V]) Evaluate(tree Tree[V], inputs []V) (V, error) {
+	var zero V
+	if err := set.Validate(tree); err != nil {
+		return zero, err
+	}
+	return set.evaluate(tree, inputs, make([]V, 0, len(tree)), make([]V, set.maxArity)), nil
+}
+
+// Evaluator returns a function that evaluates tree on many inputs without validating it or allocating again.
+// The function reuses one stack between calls, so it is not safe for concurrent use: give each goroutine its own
+func (set *PrimitiveSet[V]) Evaluator(tree Tree[V]) (func(inputs []V) V, error) {
+	if err := set.Validate(tree); err != nil {
+		return nil, err
+	}
+	stack, args := make([]V, 0, len(tree)), make([]V, set.maxArity)
+	return func(inputs []V) V {
+		return set.evaluate(tree, inputs, stack[:0], args)
+	}, nil
+}
+
+// evaluate computes a valid tree from its last node to its first, so each function finds its arguments' values
+// on top of stack, first argument last
+func (set *PrimitiveSet[V]) evaluate(tree Tree[V], inputs, stack, args []V) V {
+	for i := len(tree) - 1; i >= 0; i-- {
+		primitive := set.primitive(tree[i])
+		if primitive.Constant != nil {
+			stack = append(stack, tree[i].Value)
+			continue
+		}
+		arity := primitive.Arity()
+		for j := 0; j < arity; j++ {
+			args[j] = stack[len(stack)-1-j]
+		}
+		stack = append(stack[:len(stack)-arity], primitive.Eval(args[:arity], inputs))
+	}
+	return stack[0]
+}
@@ -0,0 +1,63 @@
+package gp
+
+import (
+	"testing"
+)
+
+func TestEvaluate(t *testing.T) {
+	t.Parallel()
+	set := typedSet(t)
+	// (if (gt x0 x1) (add x0 0.5) (mul x1 x1))
+	tree := Tree[float64]{{Primitive: 2}, {Primitive: 3}, {Primitive: 6}, {Primitive: 7}, {Primitive: 0}, {Primitive: 6}, {Primitive: 8, Value: 0.5}, {Primitive: 1}, {Primitive: 7}, {Primitive: 7}}
+	expectedFormat := "(if (gt x0 x1) (add x0 0.5) (mul x1 x1))"
+	if got := set.Format(tree); got != expectedFormat {
+		t.Error("Incorrect format.", "Expected:", expectedFormat, "Got:", got)
+	}
+	if got := set.Depth(tree); got != 2 {
+		t.Error("Incorrect depth.", "Expected:", 2, "Got:", got)
+	}
+	evaluator, err := set.Evaluator(tree)
+	if err != nil {
+		t.Fatal("Evaluator errored unexpectedly. Got:", err)
+	}
+	tests := []struct {
+		inputs   []float64
+		expected float64
+	}{
+		{[]float64{3, 2}, 3.5},
+		{[]float64{2, 3}, 9},
+	}
+	for _, test := range tests {
+		got, err := set.Evaluate(tree, test.inputs)
+		if err != nil {
+			t.Fatal("Evaluate errored unexpectedly. Got:", err)
+		}
+		if got != test.expected || evaluator(test.inputs) != test.expected {
+			t.Error("Incorrect value.", "Inputs:", test.inputs, "Expected:", test.expected, "Got:", got, evaluator(test.inputs))
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+	set := typedSet(t)
+	tests := map[string]Tree[float64]{
+		"empty":             {},
+		"incomplete":        {{Primitive: 0}, {Primitive: 6}},
+		"trailing nodes":    {{Primitive: 6}, {Primitive: 7}},
+		"unknown primitive": {{Primitive: 42}},
+		"wrong root type":   {{Primitive: 5}},
+		"wrong arg type":    {{Primitive: 0}, {Primitive: 5}, {Primitive: 6}},
+	}
+	for name, tree := range tests {
+		if err := set.Validate(tree); err == nil {
+			t.Error("Validate accepted an invalid tree:", name)
+		}
+		if _, err := set.Evaluate(tree, []float64{0, 0}); err == nil {
+			t.Error("Evaluate accepted an invalid tree:", name)
+		}
+	}
+	if err := set.Validate(Tree[float64]{{Primitive: 0}, {Primitive: 6}, {Primitive: 7}}); err != nil {
+		t.Error("Validate errored unexpectedly. Got:", err)
+	}
+}
@@ -0,0 +1,95 @@
+package ga
+
+// Phase identifies the point in a generation at which a hook fired
+type Phase string
+
+const (
+	// PhaseInit is not passed to hooks, but identifies failures creating the initial population in a RunError
+	PhaseInit            Phase = "init"
+	PhaseGenerationStart Phase = "generation start"
+	PhaseSelection       Phase = "selection"
+	PhaseCrossover       Phase = "crossover"
+	PhaseMutation        Phase = "mutation"
+	PhaseGenerationEnd   Phase = "generation end"
+)
+
+// TerminationReason describes why a run stopped
+type TerminationReason string
+
+const (
+	TerminationGenerations TerminationReason = "generations complete"
+	TerminationStagnation  TerminationReason = "stagnating change"
+	TerminationTimeLimit   TerminationReason = "time limit reached"
+	TerminationEvaluations TerminationReason = "evaluation budget exhausted"
+	TerminationCancelled   TerminationReason = "cancelled"
+	TerminationError       TerminationReason = "error"
+)
+
+// EventOf is passed to hooks as a run progresses
+type EventOf[T comparable] struct {
+	Generation int
+	Phase      Phase
+	// Stats describes the population the event refers to: the current candidates, or the offspring of a phase.
+	// Offspring are evaluated at the end of every phase whether or not a hook is set, so firing a hook never
+	// calls the fitness function
+	Stats GenerationStatsOf[T]
+	// Best is the best candidate found so far in the run
+	Best GenomeOf[T]
+	// Reason and Err are set for OnTerminate. Err is the error the run returned, if any
+	Reason TerminationReason
+	Err    error
+}
+
+// Event is passed to the hooks of a bitstring GA
+type Event = EventOf[string]
+
+type HookOf[T comparable] func(EventOf[T])
+
+type Hook = HookOf[string]
+
+// HooksOf are called with an Event at each stage of a run. Nil hooks are skipped
+type HooksOf[T comparable] struct {
+	OnGenerationStart HookOf[T]
+	OnSelection       HookOf[T]
+	OnCrossover       HookOf[T]
+	OnMutation        HookOf[T]
+	OnGenerationEnd   HookOf[T]
+	OnImprovement     HookOf[T]
+	OnTerminate       HookOf[T]
+}
+
+type Hooks = HooksOf[string]
+
+// SetHooks changes the hooks called during a run
+func (genA *GeneticAlgorithmOf[T]) SetHooks(hooks HooksOf[T]) {
+	genA.Hooks = hooks
+}
+
+// firePhase calls hook with the statistics of the evaluated candidates of candidatePool as of the given generation
+func (genA *GeneticAlgorithmOf[T]) firePhase(hook HookOf[T], phase Phase, generation int, candidatePool PopulationOf[T]) {
+	if hook == nil {
+		return
+	}
+	stats := genA.evaluatedStats(candidatePool)
+	stats.Generation = generation
+	hook(EventOf[T]{
+		Generation: generation,
+		Phase:      phase,
+		Stats:      stats,
+		Best:       genA.BestCandidate.duplicate(),
+	})
+}
+
+// terminate calls the OnTerminate hook with the reason a run stopped
+func (genA *GeneticAlgorithmOf[T]) terminate(reason TerminationReason, err error) {
+	if genA.Hooks.OnTerminate == nil {
+		return
+	}
+	genA.Hooks.OnTerminate(EventOf[T]{
+		Generation: genA.Generations,
+		Stats:      genA.evaluatedStats(genA.Candidates),
+		Best:       genA.BestCandidate.duplicate(),
+		Reason:     reason,
+		Err:        err,
+	})
+}
@@ -0,0 +1,157 @@
+package ga
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+)
+
+func TestHooks(t *testing.T) {
+	t.Parallel()
+	var genA = NewGeneticAlgorithm()
+	genA.SetSeed(3)
+	genA.SetOutputFunc(func(a ...interface{}) {})
+
+	counts := make(map[Phase]int)
+	record := func(event Event) {
+		counts[event.Phase]++
+		if len(event.Stats.Best.Sequence) == 0 || event.Stats.Unique == 0 {
+			t.Error("Event missing population statistics. Got:", event)
+		}
+	}
+	var (
+		improvements []float64
+		terminations []Event
+		generations  []int
+	)
+	genA.SetHooks(Hooks{
+		OnGenerationStart: record,
+		OnSelection:       record,
+		OnCrossover:       record,
+		OnMutation:        record,
+		OnGenerationEnd: func(event Event) {
+			record(event)
+			generations = append(generations, event.Generation)
+		},
+		OnImprovement: func(event Event) {
+			improvements = append(improvements, event.Best.Fitness)
+		},
+		OnTerminate: func(event Event) {
+			terminations = append(terminations, event)
+		},
+	})
+
+	if err := genA.Run(10, 10, 3, true, true, false); err != nil {
+		t.Error("GA errored unexpectedly. Got:", err)
+	}
+
+	for _, phase := range []Phase{PhaseGenerationStart, PhaseSelection, PhaseCrossover, PhaseMutation, PhaseGenerationEnd} {
+		if counts[phase] != 3 {
+			t.Error("Hook fired wrong number of times.", "Phase:", phase, "Expected:", 3, "Got:", counts[phase])
+		} else {
+			t.Log("Hook fired once per generation.", "Phase:", phase)
+		}
+	}
+	for i, generation := range generations {
+		if generation != i+1 {
+			t.Error("Wrong generation number.", "Expected:", i+1, "Got:", generation)
+		}
+	}
+	if len(improvements) == 0 {
+		t.Error("OnImprovement never fired")
+	}
+	for i := 1; i < len(improvements); i++ {
+		if improvements[i] <= improvements[i-1] {
+			t.Error("OnImprovement fired without improvement.", "Was:", improvements[i-1], "Now:", improvements[i])
+		}
+	}
+	if len(terminations) != 1 || terminations[0].Reason != TerminationGenerations {
+		t.Error("OnTerminate did not report completion.", "Expected:", TerminationGenerations, "Got:", terminations)
+	} else if terminations[0].Best.Fitness != genA.BestCandidate.Fitness {
+		t.Error("OnTerminate reported wrong best candidate.", "Expected:", genA.BestCandidate, "Got:", terminations[0].Best)
+	}
+}
+
+func TestHooksEvaluations(t *testing.T) {
+	t.Parallel()
+	run := func(hooks Hooks) int {
+		var genA = NewGeneticAlgorithm()
+		genA.SetSeed(3)
+		genA.SetLogger(slog.New(slog.NewTextHandler(io.Discard, nil)))
+		genA.SetHooks(hooks)
+		if err := genA.Run(10, 10, 5, true, true, false); err != nil {
+			t.Error("GA errored unexpectedly. Got:", err)
+		}
+		return genA.Evaluations
+	}
+
+	record := func(event Event) {}
+	expected := run(Hooks{})
+	got := run(Hooks{
+		OnGenerationStart: record,
+		OnSelection:       record,
+		OnCrossover:       record,
+		OnMutation:        record,
+		OnGenerationEnd:   record,
+		OnImprovement:     record,
+		OnTerminate:       record,
+	})
+	if got != expected {
+		t.Error("Hooks caused fitness evaluations.", "Expected:", expected, "Got:", got)
+	} else {
+		t.Log("Hooks caused no fitness evaluations.", "Got:", got)
+	}
+}
+
+func TestHooksTerminationReason(t *testing.T) {
+	t.Parallel()
+	testReason := func(name string, expected TerminationReason, setup func(*GeneticAlgorithm, *RunConfig) context.Context) {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			var genA = NewGeneticAlgorithm()
+			genA.SetSeed(3)
+			genA.SetOutputFunc(func(a ...interface{}) {})
+			var got TerminationReason
+			var gotErr error
+			genA.SetHooks(Hooks{OnTerminate: func(event Event) {
+				got = event.Reason
+				gotErr = event.Err
+			}})
+			config := NewRunConfig(10, 10, 100)
+			ctx := setup(&genA, &config)
+
+			err := genA.RunWithConfigContext(ctx, config)
+			if got != expected {
+				t.Error("Wrong termination reason.", "Expected:", expected, "Got:", got)
+			} else {
+				t.Log("Correct termination reason.", "Expected:", expected, "Got:", got)
+			}
+			if gotErr != err {
+				t.Error("Termination error does not match run error.", "Expected:", err, "Got:", gotErr)
+			}
+		})
+	}
+
+	testReason("Stagnation", TerminationStagnation, func(genA *GeneticAlgorithm, config *RunConfig) context.Context {
+		genA.SetFitnessFunc(func(gene Genome) float64 { return 0 })
+		config.TerminateEarly = true
+		return context.Background()
+	})
+	testReason("Evaluations", TerminationEvaluations, func(genA *GeneticAlgorithm, config *RunConfig) context.Context {
+		config.MaxEvaluations = 1
+		return context.Background()
+	})
+	testReason("TimeLimit", TerminationTimeLimit, func(genA *GeneticAlgorithm, config *RunConfig) context.Context {
+		genA.SetTimeLimit(1)
+		return context.Background()
+	})
+	testReason("Cancelled", TerminationCancelled, func(genA *GeneticAlgorithm, config *RunConfig) context.Context {
+		ctx, cancel := context.WithCancel(context.Background())
+		genA.SetHooks(Hooks{
+			OnGenerationEnd: func(event Event) { cancel() },
+			OnTerminate:     genA.Hooks.OnTerminate,
+		})
+		return ctx
+	})
+}
@@ -4,7 +4,12 @@ import (
 	"math/rand"
 )
 
-type MutateFunction func(Genome, *rand.Rand) Genome
+// MutateFunctionOf returns a mutated genome. Selected offspring can share a sequence, so mutators should
+// Copy the genome before changing it. The result is always re-evaluated
+type MutateFunctionOf[T comparable] func(GenomeOf[T], *rand.Rand) GenomeOf[T]
+
+// MutateFunction mutates bitstring genomes
+type MutateFunction = MutateFunctionOf[string]
 
 var DefaultMutateFunc MutateFunction = func(gene Genome, random *rand.Rand) Genome {
 	gene = gene.Copy()
@@ -17,7 +22,78 @@ var DefaultMutateFunc MutateFunction = func(gene Genome, random *rand.Rand) Geno
 	return gene
 }
 
+// MutateErrFunctionOf is a mutate function that can fail. An error stops the run with a *RunError
+type MutateErrFunctionOf[T comparable] func(GenomeOf[T], *rand.Rand) (GenomeOf[T], error)
+
+// MutateErrFunction mutates bitstring genomes and can fail
+type MutateErrFunction = MutateErrFunctionOf[string]
+
 // SetMutateFunc changes the mutate function to the function specified
-func (genA *GeneticAlgorithm) SetMutateFunc(f MutateFunction) {
+func (genA *GeneticAlgorithmOf[T]) SetMutateFunc(f MutateFunctionOf[T]) {
 	genA.Mutate = f
+	genA.MutateErr = nil
+}
+
+// SetMutateErrFunc changes the mutate function to one that can fail.
+// Mutate is set to a wrapper around f that returns the gene unchanged on error, for callers that mutate directly
+func (genA *GeneticAlgorithmOf[T]) SetMutateErrFunc(f MutateErrFunctionOf[T]) {
+	genA.SetMutateFunc(func(gene GenomeOf[T], random *rand.Rand) GenomeOf[T] {
+		mutated, err := f(gene, random)
+		if err != nil {
+			return gene
+		}
+		return mutated
+	})
+	genA.MutateErr = f
+}
+
+// mutate applies MutateErr to gene if set, otherwise Mutate. The result is marked as not yet evaluated,
+// so a mutator that edits gene in place does not keep its fitness from before mutation
+func (genA *GeneticAlgorithmOf[T]) mutate(gene GenomeOf[T]) (GenomeOf[T], error) {
+	var (
+		mutated GenomeOf[T]
+		err     error
+	)
+	if genA.MutateErr != nil {
+		mutated, err = genA.MutateErr(gene, genA.RandomEngine)
+	} else {
+		mutated = genA.Mutate(gene, genA.RandomEngine)
+	}
+	mutated.Evaluated = false
+	mutated.Fitness = 0
+	return mutated, err
+}
+
+// MutateGeneFunctionOf returns a mutated copy of a single gene
+type MutateGeneFunctionOf[T comparable] func(T, *rand.Rand) T
+
+// MutateGeneFunction mutates a single bit
+type MutateGeneFunction = MutateGeneFunctionOf[string]
+
+var DefaultMutateGeneFunc MutateGeneFunction = func(gene string, random *rand.Rand) string {
+	if gene == "1" {
+		return "0"
+	}
+	return "1"
+}
+
+// SetMutateGeneFunc changes the per-gene mutate function used when MutationProbability is set
+func (genA *GeneticAlgorithmOf[T]) SetMutateGeneFunc(f MutateGeneFunctionOf[T]) {
+	genA.MutateGene = f
+}
+
+// mutateGenes returns a copy of gene with each gene mutated with the given probability.
+// The copy keeps its fitness if no gene was changed
+func (genA *GeneticAlgorithmOf[T]) mutateGenes(gene GenomeOf[T], probability float64) GenomeOf[T] {
+	gene = gene.duplicate()
+	for i := range gene.Sequence {
+		if genA.RandomEngine.Float64() < probability {
+			mutated := genA.MutateGene(gene.Sequence[i], genA.RandomEngine)
+			if mutated != gene.Sequence[i] {
+				gene.Sequence[i] = mutated
+				gene.Evaluated = false
+			}
+		}
+	}
+	return gene
 }
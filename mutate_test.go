@@ -13,7 +13,7 @@ func TestSetMutateFunc(t *testing.T) {
 	genA.SetOutputFunc(func(a ...interface{}) { t.Log(a...) })
 
 	genA.SetMutateFunc(func(gene Genome, random *rand.Rand) Genome {
-		return Genome{Bitstring{"1", "2", "3", "4"}}
+		return Genome{Sequence: Bitstring{"1", "2", "3", "4"}}
 	})
 
 	output := fmt.Sprint(genA.Mutate(Genome{}, genA.RandomEngine))
@@ -28,7 +28,7 @@ func TestSetMutateFunc(t *testing.T) {
 func TestDefaultMutateFunc(t *testing.T) {
 	t.Parallel()
 	genA := NewGeneticAlgorithm()
-	gene := Genome{Bitstring{"1", "0", "1", "0", "1"}}
+	gene := Genome{Sequence: Bitstring{"1", "0", "1", "0", "1"}}
 	geneOutput := genA.Mutate(gene, genA.RandomEngine)
 
 	if gene.String() == geneOutput.String() {
@@ -37,3 +37,76 @@ func TestDefaultMutateFunc(t *testing.T) {
 		t.Log("Mutate successfully changed bitstrings. At least one mutation should occur. Was:", gene, "Mutated:", geneOutput)
 	}
 }
+
+func TestDefaultMutateGeneFunc(t *testing.T) {
+	t.Parallel()
+	genA := NewGeneticAlgorithm()
+	for gene, expected := range map[string]string{"0": "1", "1": "0"} {
+		got := genA.MutateGene(gene, genA.RandomEngine)
+		if got != expected {
+			t.Error("Gene not flipped.", "Was:", gene, "Expected:", expected, "Got:", got)
+		} else {
+			t.Log("Gene flipped.", "Was:", gene, "Expected:", expected, "Got:", got)
+		}
+	}
+}
+
+func TestMutationProbability(t *testing.T) {
+	t.Parallel()
+	genA := NewGeneticAlgorithm()
+	genA.SetSeed(3)
+	gene := Genome{Sequence: Bitstring{"1", "0", "1", "0", "1"}}
+
+	expected := "{[0 1 0 1 0 ]}"
+	got := genA.mutateGenes(gene, 1).String()
+	if got != expected {
+		t.Error("Not every gene mutated with probability 1.", "Expected:", expected, "Got:", got)
+	} else {
+		t.Log("Every gene mutated with probability 1.", "Expected:", expected, "Got:", got)
+	}
+
+	expected = gene.String()
+	got = genA.mutateGenes(gene, 0).String()
+	if got != expected {
+		t.Error("Genes mutated with probability 0.", "Expected:", expected, "Got:", got)
+	} else {
+		t.Log("No genes mutated with probability 0.", "Expected:", expected, "Got:", got)
+	}
+
+	flipped := 0
+	for i := 0; i < 1000; i++ {
+		for j, val := range genA.mutateGenes(gene, 0.1).Sequence {
+			if val != gene.Sequence[j] {
+				flipped++
+			}
+		}
+	}
+	if flipped < 400 || flipped > 600 {
+		t.Error("Mutation rate far from probability.", "Expected about:", 500, "Got:", flipped)
+	} else {
+		t.Log("Mutation rate matches probability.", "Expected about:", 500, "Got:", flipped)
+	}
+}
+
+func TestMutateInPlace(t *testing.T) {
+	t.Parallel()
+	var genA = NewGeneticAlgorithm()
+	genA.SetSeed(3)
+	genA.SetOutputFunc(func(a ...interface{}) {})
+	genA.SetMutateFunc(func(gene Genome, random *rand.Rand) Genome {
+		for i := range gene.Sequence {
+			gene.Sequence[i] = "0"
+		}
+		return gene
+	})
+	config := NewRunConfig(10, 8, 1)
+	config.Crossover = false
+	if err := genA.RunWithConfig(config); err != nil {
+		t.Fatal("Run errored unexpectedly. Got:", err)
+	}
+	for _, val := range genA.Candidates {
+		if val.Fitness != 0 {
+			t.Error("Mutated candidate kept its fitness from before mutation.", "Expected:", 0, "Got:", val)
+		}
+	}
+}
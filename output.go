@@ -1,11 +1,271 @@
 package ga
 
-import "fmt"
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
+	"strconv"
+	"strings"
+)
 
+// Logger is the leveled logger a run reports to. Population dumps are logged at debug level, and the result of a
+// run at info level. *slog.Logger satisfies Logger
+type Logger interface {
+	Debug(msg string, args ...any)
+	Info(msg string, args ...any)
+	Warn(msg string, args ...any)
+}
+
+// SetLogger changes the logger a run reports to. The default is slog.Default()
+func (genA *GeneticAlgorithmOf[T]) SetLogger(logger Logger) {
+	genA.Logger = logger
+}
+
+// debugEnabled reports whether the Logger records debug messages, so that expensive summaries can be skipped.
+// Loggers without an Enabled method are assumed to record everything
+func (genA *GeneticAlgorithmOf[T]) debugEnabled() bool {
+	if leveled, ok := genA.Logger.(interface {
+		Enabled(context.Context, slog.Level) bool
+	}); ok {
+		return leveled.Enabled(context.Background(), slog.LevelDebug)
+	}
+	return true
+}
+
+// PrintToConsole is an output function for SetOutputFunc that prints every message to standard output.
+// To export a run for spreadsheets or notebooks instead, use SetHistoryWriter with a CSVWriter or JSONLinesWriter
 var PrintToConsole = func(a ...interface{}) {
 	fmt.Println(a...)
 }
 
-func (genA *GeneticAlgorithm) SetOutputFunc(f func(a ...interface{})) {
-	genA.Output = f
+// OutputLogger adapts a Println-style output function into a Logger that passes on messages of every level,
+// followed by their key-value pairs
+type OutputLogger func(a ...interface{})
+
+func (output OutputLogger) log(msg string, args []any) {
+	output(append([]interface{}{msg}, args...)...)
+}
+
+func (output OutputLogger) Debug(msg string, args ...any) { output.log(msg, args) }
+func (output OutputLogger) Info(msg string, args ...any)  { output.log(msg, args) }
+func (output OutputLogger) Warn(msg string, args ...any)  { output.log(msg, args) }
+
+// SetOutputFunc sends every message of a run to f.
+//
+// Deprecated: use SetLogger, with OutputLogger to adapt an existing output function
+func (genA *GeneticAlgorithmOf[T]) SetOutputFunc(f func(a ...interface{})) {
+	if f == nil {
+		genA.Logger = nil
+		return
+	}
+	genA.Logger = OutputLogger(f)
+}
+
+// HistoryWriterOf receives the statistics and population of every generation of a run, starting with the
+// initial population as generation 0
+type HistoryWriterOf[T comparable] interface {
+	WriteGeneration(stats GenerationStatsOf[T], population PopulationOf[T]) error
+}
+
+// HistoryWriter receives the history of a bitstring GA
+type HistoryWriter = HistoryWriterOf[string]
+
+// SetHistoryWriter sets a writer that each generation is exported to. A nil writer disables exporting
+func (genA *GeneticAlgorithmOf[T]) SetHistoryWriter(w HistoryWriterOf[T]) {
+	genA.HistoryWriter = w
+}
+
+// CSVStatsHeader is the header row written by CSVWriter for generation statistics. Sequences are written with
+// their genes separated by spaces, and elapsed time in seconds. Columns are only ever added to the end
+var CSVStatsHeader = []string{
+	"generation", "min", "max", "mean", "median", "stddev",
+	"best_fitness", "best_sequence", "unique", "evaluations", "elapsed_seconds",
+}
+
+// CSVPopulationHeader is the header row written by CSVWriter for each genome of a population, in population order.
+// Columns are only ever added to the end
+var CSVPopulationHeader = []string{"generation", "index", "fitness", "sequence"}
+
+// CSVWriterOf exports generation statistics as CSV, and optionally every population to a second CSV stream
+type CSVWriterOf[T comparable] struct {
+	stats      *csv.Writer
+	population *csv.Writer
+	started    bool
+}
+
+// CSVWriter exports the history of a bitstring GA as CSV
+type CSVWriter = CSVWriterOf[string]
+
+// NewCSVWriter returns a CSVWriter writing statistics to stats, and populations to population unless it is nil
+func NewCSVWriter(stats, population io.Writer) *CSVWriter {
+	return NewCSVWriterOf[string](stats, population)
+}
+
+// NewCSVWriterOf returns a CSVWriterOf writing statistics to stats, and populations to population unless it is nil
+func NewCSVWriterOf[T comparable](stats, population io.Writer) *CSVWriterOf[T] {
+	writer := &CSVWriterOf[T]{stats: csv.NewWriter(stats)}
+	if population != nil {
+		writer.population = csv.NewWriter(population)
+	}
+	return writer
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+func joinSequence[T comparable](sequence Sequence[T]) string {
+	genes := make([]string, len(sequence))
+	for i, val := range sequence {
+		genes[i] = fmt.Sprint(val)
+	}
+	return strings.Join(genes, " ")
+}
+
+// WriteGeneration writes one row of statistics, and one row per genome of population, flushing both streams
+func (w *CSVWriterOf[T]) WriteGeneration(stats GenerationStatsOf[T], population PopulationOf[T]) error {
+	if !w.started {
+		w.started = true
+		w.stats.Write(CSVStatsHeader)
+		if w.population != nil {
+			w.population.Write(CSVPopulationHeader)
+		}
+	}
+
+	w.stats.Write([]string{
+		strconv.Itoa(stats.Generation),
+		formatFloat(stats.Min),
+		formatFloat(stats.Max),
+		formatFloat(stats.Mean),
+		formatFloat(stats.Median),
+		formatFloat(stats.StdDev),
+		formatFloat(stats.BestFitness),
+		joinSequence(stats.Best.Sequence),
+		strconv.Itoa(stats.Unique),
+		strconv.Itoa(stats.Evaluations),
+		formatFloat(stats.Elapsed.Seconds()),
+	})
+	w.stats.Flush()
+	if err := w.stats.Error(); err != nil {
+		return err
+	}
+
+	if w.population == nil {
+		return nil
+	}
+	for i, val := range population {
+		w.population.Write([]string{
+			strconv.Itoa(stats.Generation),
+			strconv.Itoa(i),
+			formatFloat(val.Fitness),
+			joinSequence(val.Sequence),
+		})
+	}
+	w.population.Flush()
+	return w.population.Error()
+}
+
+// JSONFloat is a float64 that encodes NaN and infinities, which JSON numbers cannot represent, as the strings
+// "NaN", "+Inf" and "-Inf"
+type JSONFloat float64
+
+// MarshalJSON encodes f as a number, or as a string if it is not finite
+func (f JSONFloat) MarshalJSON() ([]byte, error) {
+	if math.IsNaN(float64(f)) || math.IsInf(float64(f), 0) {
+		return json.Marshal(strconv.FormatFloat(float64(f), 'g', -1, 64))
+	}
+	return json.Marshal(float64(f))
+}
+
+// UnmarshalJSON decodes a number, or one of the strings written by MarshalJSON
+func (f *JSONFloat) UnmarshalJSON(data []byte) error {
+	if len(data) == 0 || data[0] != '"' {
+		return json.Unmarshal(data, (*float64)(f))
+	}
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return err
+	}
+	value, err := strconv.ParseFloat(text, 64)
+	if err != nil {
+		return err
+	}
+	*f = JSONFloat(value)
+	return nil
+}
+
+// JSONGenerationOf is the record written by JSONLinesWriterOf, one per line. Fields are only ever added.
+// Fitness statistics that are not finite are written as strings, as described by JSONFloat
+type JSONGenerationOf[T comparable] struct {
+	Generation     int               `json:"generation"`
+	Min            JSONFloat         `json:"min"`
+	Max            JSONFloat         `json:"max"`
+	Mean           JSONFloat         `json:"mean"`
+	Median         JSONFloat         `json:"median"`
+	StdDev         JSONFloat         `json:"stddev"`
+	BestFitness    JSONFloat         `json:"best_fitness"`
+	BestSequence   []T               `json:"best_sequence"`
+	Unique         int               `json:"unique"`
+	Evaluations    int               `json:"evaluations"`
+	ElapsedSeconds float64           `json:"elapsed_seconds"`
+	Population     []JSONGenomeOf[T] `json:"population,omitempty"`
+}
+
+// JSONGeneration is the record written by JSONLinesWriter
+type JSONGeneration = JSONGenerationOf[string]
+
+// JSONGenomeOf is a single genome of a JSONGenerationOf's population
+type JSONGenomeOf[T comparable] struct {
+	Sequence []T       `json:"sequence"`
+	Fitness  JSONFloat `json:"fitness"`
+}
+
+// JSONGenome is a single bitstring genome of a JSONGeneration
+type JSONGenome = JSONGenomeOf[string]
+
+// JSONLinesWriterOf exports each generation as a JSONGenerationOf on its own line
+type JSONLinesWriterOf[T comparable] struct {
+	encoder    *json.Encoder
+	population bool
+}
+
+// JSONLinesWriter exports the history of a bitstring GA as JSON Lines
+type JSONLinesWriter = JSONLinesWriterOf[string]
+
+// NewJSONLinesWriter returns a JSONLinesWriter writing to w, including every population if population is set
+func NewJSONLinesWriter(w io.Writer, population bool) *JSONLinesWriter {
+	return NewJSONLinesWriterOf[string](w, population)
+}
+
+// NewJSONLinesWriterOf returns a JSONLinesWriterOf writing to w, including every population if population is set
+func NewJSONLinesWriterOf[T comparable](w io.Writer, population bool) *JSONLinesWriterOf[T] {
+	return &JSONLinesWriterOf[T]{json.NewEncoder(w), population}
+}
+
+// WriteGeneration writes stats, and population if enabled, as a single line of JSON
+func (w *JSONLinesWriterOf[T]) WriteGeneration(stats GenerationStatsOf[T], population PopulationOf[T]) error {
+	record := JSONGenerationOf[T]{
+		Generation:     stats.Generation,
+		Min:            JSONFloat(stats.Min),
+		Max:            JSONFloat(stats.Max),
+		Mean:           JSONFloat(stats.Mean),
+		Median:         JSONFloat(stats.Median),
+		StdDev:         JSONFloat(stats.StdDev),
+		BestFitness:    JSONFloat(stats.BestFitness),
+		BestSequence:   stats.Best.Sequence,
+		Unique:         stats.Unique,
+		Evaluations:    stats.Evaluations,
+		ElapsedSeconds: stats.Elapsed.Seconds(),
+	}
+	if w.population {
+		record.Population = make([]JSONGenomeOf[T], 0, len(population))
+		for _, val := range population {
+			record.Population = append(record.Population, JSONGenomeOf[T]{val.Sequence, JSONFloat(val.Fitness)})
+		}
+	}
+	return w.encoder.Encode(record)
 }
@@ -1,15 +1,88 @@
 package ga
 
 import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"log/slog"
+	"math"
+	"strings"
 	"testing"
 )
 
-func TestDefaultOutputFunc(t *testing.T) {
+func TestDefaultLogger(t *testing.T) {
 	t.Parallel()
 	var genA = NewGeneticAlgorithm()
-	genA.Output("Test")
-	t.Log("Didn't panic. Assume success. No other way to test.")
+	if genA.Logger != slog.Default() {
+		t.Error("Default logger not set. Expected:", slog.Default(), "Got:", genA.Logger)
+	} else {
+		t.Log("Default logger set.")
+	}
+}
+
+func TestSetLogger(t *testing.T) {
+	t.Parallel()
+	var genA = NewGeneticAlgorithm()
+	genA.SetSeed(3)
+	var buffer bytes.Buffer
+	genA.SetLogger(slog.New(slog.NewTextHandler(&buffer, &slog.HandlerOptions{Level: slog.LevelInfo})))
+
+	if err := genA.Run(10, 10, 10, true, true, false); err != nil {
+		t.Error("GA errored unexpectedly. Got:", err)
+	}
+
+	output := buffer.String()
+	if strings.Contains(output, "level=DEBUG") || strings.Contains(output, "population") {
+		t.Error("Debug messages logged at info level. Got:", output)
+	}
+	if strings.Count(output, "\n") != 1 || !strings.Contains(output, "best candidate found") {
+		t.Error("Expected a single result line. Got:", output)
+	} else {
+		t.Log("Only the result was logged. Got:", output)
+	}
+}
+
+func TestSetLogger_Debug(t *testing.T) {
+	t.Parallel()
+	var genA = NewGeneticAlgorithm()
+	genA.SetSeed(3)
+	var buffer bytes.Buffer
+	genA.SetLogger(slog.New(slog.NewTextHandler(&buffer, &slog.HandlerOptions{Level: slog.LevelDebug})))
+
+	if err := genA.Run(10, 10, 2, true, true, false); err != nil {
+		t.Error("GA errored unexpectedly. Got:", err)
+	}
+
+	expected := []string{"start population", "selection offspring", "crossover offspring", "mutation offspring", "final population"}
+	for _, title := range expected {
+		if strings.Count(buffer.String(), "msg=\""+title+"\"") != 2 {
+			t.Error("Phase summary not logged once per generation. Expected:", title, "Got:", buffer.String())
+		}
+	}
+}
+
+func TestSetLogger_DebugEvaluations(t *testing.T) {
+	t.Parallel()
+	run := func(level slog.Level) int {
+		var genA = NewGeneticAlgorithm()
+		genA.SetSeed(3)
+		genA.SetLogger(slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: level})))
+		if err := genA.Run(10, 10, 5, true, true, false); err != nil {
+			t.Error("GA errored unexpectedly. Got:", err)
+		}
+		return genA.Evaluations
+	}
+
+	expected := run(slog.LevelInfo)
+	got := run(slog.LevelDebug)
+	if got != expected {
+		t.Error("Debug logging caused fitness evaluations.", "Expected:", expected, "Got:", got)
+	} else {
+		t.Log("Debug logging caused no fitness evaluations.", "Got:", got)
+	}
 }
 
 func TestSetOutputFunc(t *testing.T) {
@@ -24,12 +97,174 @@ func TestSetOutputFunc(t *testing.T) {
 		output(fmt.Sprint(a))
 	})
 
-	genA.Output("output string")
+	genA.Logger.Info("output string", "key", 1)
 
-	expectedOutput := "[output string]"
+	expectedOutput := "[output string key 1]"
 	if expectedOutput != gotOutput {
 		t.Error("Output func not set. Expected:", expectedOutput, "Got:", gotOutput)
 	} else {
 		t.Log("Output func set correctly. Expected:", expectedOutput, "Got:", gotOutput)
 	}
 }
+
+func TestCSVWriter(t *testing.T) {
+	t.Parallel()
+	var genA = NewGeneticAlgorithm()
+	genA.SetSeed(3)
+	genA.SetOutputFunc(func(a ...interface{}) {})
+	var stats, population bytes.Buffer
+	genA.SetHistoryWriter(NewCSVWriter(&stats, &population))
+
+	if err := genA.Run(4, 4, 2, true, true, false); err != nil {
+		t.Error("GA errored unexpectedly. Got:", err)
+	}
+
+	statsRows, err := csv.NewReader(&stats).ReadAll()
+	if err != nil {
+		t.Error("Statistics are not valid CSV. Got:", err)
+	}
+	if len(statsRows) != 4 || strings.Join(statsRows[0], ",") != strings.Join(CSVStatsHeader, ",") {
+		t.Error("Incorrect statistics rows.", "Expected: header and 3 generations. Got:", statsRows)
+	} else {
+		t.Log("Statistics exported.", statsRows)
+	}
+
+	populationRows, err := csv.NewReader(&population).ReadAll()
+	if err != nil {
+		t.Error("Population is not valid CSV. Got:", err)
+	}
+	if len(populationRows) != 13 || strings.Join(populationRows[0], ",") != strings.Join(CSVPopulationHeader, ",") {
+		t.Error("Incorrect population rows.", "Expected: header and 12 genomes. Got:", populationRows)
+	}
+	last := populationRows[len(populationRows)-1]
+	expected := fmt.Sprint([]string{"2", "3", formatFloat(genA.Candidates[3].Fitness), strings.Join(genA.Candidates[3].Sequence, " ")})
+	got := fmt.Sprint(last)
+	if got != expected {
+		t.Error("Incorrect population row.", "Expected:", expected, "Got:", got)
+	}
+}
+
+func TestCSVWriterStatsOnly(t *testing.T) {
+	t.Parallel()
+	var stats bytes.Buffer
+	writer := NewCSVWriter(&stats, nil)
+	if err := writer.WriteGeneration(GenerationStats{Generation: 1}, Population{{Sequence: Bitstring{"1"}}}); err != nil {
+		t.Error("Writer errored unexpectedly. Got:", err)
+	}
+	if lines := strings.Count(stats.String(), "\n"); lines != 2 {
+		t.Error("Incorrect number of lines.", "Expected:", 2, "Got:", lines)
+	}
+}
+
+func TestJSONLinesWriter(t *testing.T) {
+	t.Parallel()
+	var genA = NewGeneticAlgorithm()
+	genA.SetSeed(3)
+	genA.SetOutputFunc(func(a ...interface{}) {})
+	var output bytes.Buffer
+	genA.SetHistoryWriter(NewJSONLinesWriter(&output, true))
+
+	if err := genA.Run(4, 4, 2, true, true, false); err != nil {
+		t.Error("GA errored unexpectedly. Got:", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(output.String()), "\n")
+	if len(lines) != 3 {
+		t.Error("Incorrect number of lines.", "Expected:", 3, "Got:", len(lines))
+	}
+	for i, line := range lines {
+		var record JSONGeneration
+		if err := json.Unmarshal([]byte(line), &record); err != nil {
+			t.Error("Line is not valid JSON.", "Line:", line, "Got:", err)
+			continue
+		}
+		if record.Generation != i || len(record.Population) != 4 {
+			t.Error("Incorrect record.", "Expected generation:", i, "Got:", record)
+		}
+		if float64(record.BestFitness) != genA.History[i].BestFitness {
+			t.Error("Incorrect best fitness.", "Expected:", genA.History[i].BestFitness, "Got:", record.BestFitness)
+		}
+	}
+	t.Log("Exported:", output.String())
+}
+
+func TestJSONLinesWriter_NonFinite(t *testing.T) {
+	t.Parallel()
+	var genA = NewGeneticAlgorithm()
+	genA.SetSeed(3)
+	genA.SetOutputFunc(func(a ...interface{}) {})
+	genA.SetFitnessFunc(func(gene Genome) float64 {
+		if gene.Sequence[0] == "0" {
+			return math.Inf(-1)
+		}
+		return DefaultFitnessFunc(gene)
+	})
+	var output bytes.Buffer
+	genA.SetHistoryWriter(NewJSONLinesWriter(&output, true))
+
+	if err := genA.Run(10, 10, 2, true, true, false); err != nil {
+		t.Error("GA errored unexpectedly. Got:", err)
+	}
+
+	var record JSONGeneration
+	line := strings.Split(output.String(), "\n")[0]
+	if err := json.Unmarshal([]byte(line), &record); err != nil {
+		t.Error("Line is not valid JSON.", "Line:", line, "Got:", err)
+	} else if !math.IsInf(float64(record.Min), -1) || !math.IsNaN(float64(record.StdDev)) {
+		t.Error("Non-finite statistics not preserved.", "Expected:", math.Inf(-1), math.NaN(), "Got:", record.Min, record.StdDev)
+	} else {
+		t.Log("Non-finite statistics preserved.", "Got:", line)
+	}
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write(p []byte) (int, error) {
+	return 0, errors.New("disk full")
+}
+
+func TestHistoryWriterError(t *testing.T) {
+	t.Parallel()
+	var genA = NewGeneticAlgorithm()
+	genA.SetSeed(3)
+	genA.SetOutputFunc(func(a ...interface{}) {})
+	genA.SetHistoryWriter(NewJSONLinesWriter(failingWriter{}, false))
+	if err := genA.Run(4, 4, 2, true, true, false); err == nil {
+		t.Error("GA did not return the writer's error")
+	} else {
+		t.Log("GA returned the writer's error. Got:", err)
+	}
+}
+
+// failingHistoryWriter fails to write every generation after the initial population
+type failingHistoryWriter struct{}
+
+func (failingHistoryWriter) WriteGeneration(stats GenerationStats, population Population) error {
+	if stats.Generation > 0 {
+		return errors.New("disk full")
+	}
+	return nil
+}
+
+func TestHistoryWriterError_Step(t *testing.T) {
+	t.Parallel()
+	var genA = NewGeneticAlgorithm()
+	genA.SetSeed(3)
+	genA.SetOutputFunc(func(a ...interface{}) {})
+	genA.SetConfig(NewRunConfig(10, 10, 5))
+	genA.SetHistoryWriter(failingHistoryWriter{})
+	if err := genA.Init(10, 10); err != nil {
+		t.Fatal("GA errored unexpectedly. Got:", err)
+	}
+	candidates := genA.Candidates
+	best := genA.BestCandidate
+
+	if _, err := genA.Step(); err == nil {
+		t.Error("Step did not return the writer's error")
+	}
+	if genA.Generations != 0 || len(genA.History) != 1 || &genA.Candidates[0] != &candidates[0] || genA.BestCandidate.String() != best.String() {
+		t.Error("Failed step changed the GA.", "Expected:", 0, best, "Got:", genA.Generations, genA.BestCandidate)
+	} else {
+		t.Log("Failed step left the GA unchanged")
+	}
+}
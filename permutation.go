@@ -0,0 +1,285 @@
+package ga
+
+import (
+	"errors"
+	"math/rand"
+)
+
+// Permutation is an ordering of the integers 0 to n-1, such as the order cities are visited in a tour
+type Permutation = Sequence[int]
+
+// IsPermutation reports whether sequence contains each of the integers 0 to len(sequence)-1 exactly once
+func IsPermutation(sequence Permutation) bool {
+	seen := make([]bool, len(sequence))
+	for _, val := range sequence {
+		if val < 0 || val >= len(sequence) || seen[val] {
+			return false
+		}
+		seen[val] = true
+	}
+	return true
+}
+
+// checkPermutations returns an error unless gene and spouse are non-empty permutations of the same length
+func checkPermutations(gene, spouse GenomeOf[int]) error {
+	if len(gene.Sequence) != len(spouse.Sequence) {
+		return errors.New("permutations are not same length")
+	}
+	if len(gene.Sequence) == 0 {
+		return errors.New("permutations are empty")
+	}
+	if !IsPermutation(gene.Sequence) || !IsPermutation(spouse.Sequence) {
+		return errors.New("parents are not valid permutations")
+	}
+	return nil
+}
+
+// cutPoints returns two random points 0 <= a < b <= length delimiting a non-empty segment
+func cutPoints(length int, random *rand.Rand) (int, int) {
+	a, b := random.Intn(length+1), random.Intn(length)
+	if b >= a {
+		b++
+	}
+	if a > b {
+		a, b = b, a
+	}
+	return a, b
+}
+
+// PermutationGenerateCandidate returns a random Permutation of length elements
+var PermutationGenerateCandidate GenerateCandidateFunctionOf[int] = func(length int, random *rand.Rand) (Permutation, error) {
+	if length <= 0 {
+		return nil, errors.New("permutations cannot be zero-length")
+	}
+	return random.Perm(length), nil
+}
+
+// PMXCrossover is partially mapped crossover. Each child takes a segment from one parent, and the remaining
+// positions from the other parent, replacing duplicates through the mapping the segment defines
+var PMXCrossover CrossoverFunctionOf[int] = func(gene, spouse GenomeOf[int], random *rand.Rand) (PopulationOf[int], error) {
+	if err := checkPermutations(gene, spouse); err != nil {
+		return nil, err
+	}
+	a, b := cutPoints(len(gene.Sequence), random)
+	child := func(segment, rest Permutation) Permutation {
+		position := make([]int, len(segment))
+		for i, val := range segment {
+			position[val] = i
+		}
+		sequence := make(Permutation, len(segment))
+		for i := range sequence {
+			if i >= a && i < b {
+				sequence[i] = segment[i]
+				continue
+			}
+			val := rest[i]
+			for position[val] >= a && position[val] < b {
+				val = rest[position[val]]
+			}
+			sequence[i] = val
+		}
+		return sequence
+	}
+	return PopulationOf[int]{
+		{Sequence: child(gene.Sequence, spouse.Sequence)},
+		{Sequence: child(spouse.Sequence, gene.Sequence)},
+	}, nil
+}
+
+// OrderCrossover (OX) keeps a segment of one parent in place, and fills the remaining positions after the segment
+// with the missing elements in the order they appear in the other parent
+var OrderCrossover CrossoverFunctionOf[int] = func(gene, spouse GenomeOf[int], random *rand.Rand) (PopulationOf[int], error) {
+	if err := checkPermutations(gene, spouse); err != nil {
+		return nil, err
+	}
+	length := len(gene.Sequence)
+	a, b := cutPoints(length, random)
+	child := func(segment, rest Permutation) Permutation {
+		sequence := make(Permutation, length)
+		used := make([]bool, length)
+		for i := a; i < b; i++ {
+			sequence[i] = segment[i]
+			used[segment[i]] = true
+		}
+		position := b % length
+		for i := 0; i < length; i++ {
+			val := rest[(b+i)%length]
+			if used[val] {
+				continue
+			}
+			sequence[position] = val
+			position = (position + 1) % length
+		}
+		return sequence
+	}
+	return PopulationOf[int]{
+		{Sequence: child(gene.Sequence, spouse.Sequence)},
+		{Sequence: child(spouse.Sequence, gene.Sequence)},
+	}, nil
+}
+
+// CycleCrossover (CX) divides the positions into the cycles formed by the two parents, and builds each child by
+// taking alternate cycles from each parent, so that every element keeps the position it had in one parent
+var CycleCrossover CrossoverFunctionOf[int] = func(gene, spouse GenomeOf[int], random *rand.Rand) (PopulationOf[int], error) {
+	if err := checkPermutations(gene, spouse); err != nil {
+		return nil, err
+	}
+	length := len(gene.Sequence)
+	position := make([]int, length)
+	for i, val := range gene.Sequence {
+		position[val] = i
+	}
+	child1, child2 := make(Permutation, length), make(Permutation, length)
+	visited := make([]bool, length)
+	for start, cycle := 0, 0; start < length; start++ {
+		if visited[start] {
+			continue
+		}
+		for i := start; !visited[i]; i = position[spouse.Sequence[i]] {
+			visited[i] = true
+			if cycle%2 == 0 {
+				child1[i], child2[i] = gene.Sequence[i], spouse.Sequence[i]
+			} else {
+				child1[i], child2[i] = spouse.Sequence[i], gene.Sequence[i]
+			}
+		}
+		cycle++
+	}
+	return PopulationOf[int]{{Sequence: child1}, {Sequence: child2}}, nil
+}
+
+// EdgeRecombinationCrossover (ERX) builds each child from the adjacencies of both parents, treated as tours.
+// Starting from the first element of one parent, it moves to the unvisited neighbour with the fewest remaining
+// neighbours, breaking ties at random, and to a random unvisited element when there is none
+var EdgeRecombinationCrossover CrossoverFunctionOf[int] = func(gene, spouse GenomeOf[int], random *rand.Rand) (PopulationOf[int], error) {
+	if err := checkPermutations(gene, spouse); err != nil {
+		return nil, err
+	}
+	length := len(gene.Sequence)
+	child := func(start int) Permutation {
+		edges := make([][]int, length)
+		addEdge := func(from, to int) {
+			for _, val := range edges[from] {
+				if val == to {
+					return
+				}
+			}
+			edges[from] = append(edges[from], to)
+		}
+		for _, parent := range []Permutation{gene.Sequence, spouse.Sequence} {
+			for i, val := range parent {
+				if length > 1 {
+					addEdge(val, parent[(i+1)%length])
+					addEdge(val, parent[(i+length-1)%length])
+				}
+			}
+		}
+
+		sequence := make(Permutation, 0, length)
+		visited := make([]bool, length)
+		for current := start; ; {
+			sequence = append(sequence, current)
+			visited[current] = true
+			if len(sequence) == length {
+				return sequence
+			}
+			for _, neighbour := range edges[current] {
+				remaining := edges[neighbour][:0]
+				for _, val := range edges[neighbour] {
+					if val != current {
+						remaining = append(remaining, val)
+					}
+				}
+				edges[neighbour] = remaining
+			}
+
+			candidates := make([]int, 0, len(edges[current]))
+			for _, neighbour := range edges[current] {
+				switch {
+				case visited[neighbour]:
+				case len(candidates) == 0 || len(edges[neighbour]) < len(edges[candidates[0]]):
+					candidates = append(candidates[:0], neighbour)
+				case len(edges[neighbour]) == len(edges[candidates[0]]):
+					candidates = append(candidates, neighbour)
+				}
+			}
+			if len(candidates) == 0 {
+				for val := range visited {
+					if !visited[val] {
+						candidates = append(candidates, val)
+					}
+				}
+			}
+			current = candidates[random.Intn(len(candidates))]
+		}
+	}
+	return PopulationOf[int]{
+		{Sequence: child(gene.Sequence[0])},
+		{Sequence: child(spouse.Sequence[0])},
+	}, nil
+}
+
+// SwapMutation exchanges two random elements of a Permutation
+var SwapMutation MutateFunctionOf[int] = func(gene GenomeOf[int], random *rand.Rand) GenomeOf[int] {
+	gene = gene.Copy()
+	if len(gene.Sequence) < 2 {
+		return gene
+	}
+	a, b := cutPoints(len(gene.Sequence)-1, random)
+	gene.Sequence[a], gene.Sequence[b] = gene.Sequence[b], gene.Sequence[a]
+	return gene
+}
+
+// InsertionMutation moves a random element of a Permutation to another random position
+var InsertionMutation MutateFunctionOf[int] = func(gene GenomeOf[int], random *rand.Rand) GenomeOf[int] {
+	gene = gene.Copy()
+	if len(gene.Sequence) < 2 {
+		return gene
+	}
+	from, to := random.Intn(len(gene.Sequence)), random.Intn(len(gene.Sequence))
+	val := gene.Sequence[from]
+	if from < to {
+		copy(gene.Sequence[from:to], gene.Sequence[from+1:to+1])
+	} else {
+		copy(gene.Sequence[to+1:from+1], gene.Sequence[to:from])
+	}
+	gene.Sequence[to] = val
+	return gene
+}
+
+// InversionMutation reverses the order of a random segment of a Permutation
+var InversionMutation MutateFunctionOf[int] = func(gene GenomeOf[int], random *rand.Rand) GenomeOf[int] {
+	gene = gene.Copy()
+	if len(gene.Sequence) < 2 {
+		return gene
+	}
+	a, b := cutPoints(len(gene.Sequence), random)
+	for i, j := a, b-1; i < j; i, j = i+1, j-1 {
+		gene.Sequence[i], gene.Sequence[j] = gene.Sequence[j], gene.Sequence[i]
+	}
+	return gene
+}
+
+// ScrambleMutation shuffles a random segment of a Permutation
+var ScrambleMutation MutateFunctionOf[int] = func(gene GenomeOf[int], random *rand.Rand) GenomeOf[int] {
+	gene = gene.Copy()
+	if len(gene.Sequence) < 2 {
+		return gene
+	}
+	a, b := cutPoints(len(gene.Sequence), random)
+	segment := gene.Sequence[a:b]
+	random.Shuffle(len(segment), func(i, j int) {
+		segment[i], segment[j] = segment[j], segment[i]
+	})
+	return gene
+}
+
+// NewPermutationGeneticAlgorithm returns a GA over Permutations, using random initialisation, order crossover,
+// inversion mutation and tournament selection. The fitness function must be set before running it
+func NewPermutationGeneticAlgorithm() GeneticAlgorithmOf[int] {
+	geneticAlgorithm := NewGeneticAlgorithmOf[int]()
+	geneticAlgorithm.SetGenerateCandidate(PermutationGenerateCandidate)
+	geneticAlgorithm.SetCrossoverFunc(OrderCrossover)
+	geneticAlgorithm.SetMutateFunc(InversionMutation)
+	return geneticAlgorithm
+}
@@ -0,0 +1,151 @@
+package ga
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestIsPermutation(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		sequence Permutation
+		expected bool
+	}{
+		{Permutation{}, true},
+		{Permutation{0}, true},
+		{Permutation{2, 0, 1}, true},
+		{Permutation{1, 2, 3}, false},
+		{Permutation{0, 0, 1}, false},
+		{Permutation{-1, 0, 1}, false},
+	}
+	for _, test := range tests {
+		if got := IsPermutation(test.sequence); got != test.expected {
+			t.Error("Incorrect permutation check.", "Sequence:", test.sequence, "Expected:", test.expected, "Got:", got)
+		}
+	}
+}
+
+func TestPermutationCrossoversValid(t *testing.T) {
+	t.Parallel()
+	crossovers := map[string]CrossoverFunctionOf[int]{
+		"PMX": PMXCrossover,
+		"OX":  OrderCrossover,
+		"CX":  CycleCrossover,
+		"ERX": EdgeRecombinationCrossover,
+	}
+	random := rand.New(rand.NewSource(3))
+	for name, crossover := range crossovers {
+		for _, length := range []int{1, 2, 3, 8, 31} {
+			for i := 0; i < 200; i++ {
+				gene := GenomeOf[int]{Sequence: random.Perm(length)}
+				spouse := GenomeOf[int]{Sequence: random.Perm(length)}
+				children, err := crossover(gene, spouse, random)
+				if err != nil {
+					t.Fatal(name, "errored unexpectedly. Got:", err)
+				}
+				if len(children) != 2 {
+					t.Fatal(name, "returned incorrect number of children.", "Expected:", 2, "Got:", len(children))
+				}
+				for _, child := range children {
+					if len(child.Sequence) != length || !IsPermutation(child.Sequence) {
+						t.Fatal(name, "returned an invalid permutation.", "Parents:", gene.Sequence, spouse.Sequence, "Got:", child.Sequence)
+					}
+				}
+			}
+		}
+		if _, err := crossover(GenomeOf[int]{Sequence: Permutation{0, 1}}, GenomeOf[int]{Sequence: Permutation{0, 1, 2}}, random); err == nil {
+			t.Error(name, "accepted parents of different lengths")
+		}
+		if _, err := crossover(GenomeOf[int]{Sequence: Permutation{0, 0}}, GenomeOf[int]{Sequence: Permutation{0, 1}}, random); err == nil {
+			t.Error(name, "accepted an invalid permutation")
+		}
+		if _, err := crossover(GenomeOf[int]{Sequence: Permutation{}}, GenomeOf[int]{Sequence: Permutation{}}, random); err == nil {
+			t.Error(name, "accepted empty parents")
+		}
+	}
+}
+
+func TestCycleCrossover_Positions(t *testing.T) {
+	t.Parallel()
+	gene := GenomeOf[int]{Sequence: Permutation{0, 1, 2, 3, 4, 5, 6, 7}}
+	spouse := GenomeOf[int]{Sequence: Permutation{7, 4, 6, 0, 2, 1, 5, 3}}
+	children, err := CycleCrossover(gene, spouse, rand.New(rand.NewSource(3)))
+	if err != nil {
+		t.Fatal("Crossover errored unexpectedly. Got:", err)
+	}
+	for _, child := range children {
+		for i, val := range child.Sequence {
+			if val != gene.Sequence[i] && val != spouse.Sequence[i] {
+				t.Error("Element not in a parent's position.", "Position:", i, "Got:", child.Sequence)
+			}
+		}
+	}
+	expected := Permutation{0, 4, 6, 3, 2, 1, 5, 7}
+	if got := children[0].Sequence; got.String() != expected.String() {
+		t.Error("Incorrect cycle crossover.", "Expected:", expected, "Got:", got)
+	}
+}
+
+func TestPermutationMutationsValid(t *testing.T) {
+	t.Parallel()
+	mutations := map[string]MutateFunctionOf[int]{
+		"Swap":      SwapMutation,
+		"Insertion": InsertionMutation,
+		"Inversion": InversionMutation,
+		"Scramble":  ScrambleMutation,
+	}
+	random := rand.New(rand.NewSource(3))
+	for name, mutate := range mutations {
+		for _, length := range []int{1, 2, 3, 8, 31} {
+			for i := 0; i < 200; i++ {
+				gene := GenomeOf[int]{Sequence: random.Perm(length)}
+				original := gene.Sequence.String()
+				mutated := mutate(gene, random)
+				if gene.Sequence.String() != original {
+					t.Fatal(name, "modified its input.", "Expected:", original, "Got:", gene.Sequence)
+				}
+				if len(mutated.Sequence) != length || !IsPermutation(mutated.Sequence) {
+					t.Fatal(name, "returned an invalid permutation.", "Parent:", gene.Sequence, "Got:", mutated.Sequence)
+				}
+			}
+		}
+	}
+}
+
+func TestNewPermutationGeneticAlgorithm(t *testing.T) {
+	t.Parallel()
+	cities := 12
+	x, y := make([]float64, cities), make([]float64, cities)
+	for i := range x {
+		angle := 2 * math.Pi * float64(i) / float64(cities)
+		x[i], y[i] = math.Cos(angle), math.Sin(angle)
+	}
+	var geneticAlgorithm = NewPermutationGeneticAlgorithm()
+	geneticAlgorithm.SetSeed(3)
+	geneticAlgorithm.SetOutputFunc(func(a ...interface{}) {})
+	geneticAlgorithm.SetObjective(Minimise)
+	geneticAlgorithm.SetFitnessFunc(func(gene GenomeOf[int]) float64 {
+		length := 0.0
+		for i, city := range gene.Sequence {
+			next := gene.Sequence[(i+1)%len(gene.Sequence)]
+			length += math.Hypot(x[city]-x[next], y[city]-y[next])
+		}
+		return length
+	})
+
+	config := NewRunConfig(60, cities, 300)
+	config.EliteCount = 2
+	if err := geneticAlgorithm.RunWithConfig(config); err != nil {
+		t.Fatal("GA errored unexpectedly. Got:", err)
+	}
+	expectedFitness := 2 * float64(cities) * math.Sin(math.Pi/float64(cities))
+	if gotFitness := geneticAlgorithm.BestCandidate.Fitness; gotFitness > expectedFitness+1e-9 {
+		t.Error("GA did not find the shortest tour.", "Expected:", expectedFitness, "Got:", gotFitness)
+	} else {
+		t.Log("GA found the shortest tour.", "Expected:", expectedFitness, "Got:", geneticAlgorithm.BestCandidate)
+	}
+	if !IsPermutation(geneticAlgorithm.BestCandidate.Sequence) {
+		t.Error("Best candidate is not a valid permutation. Got:", geneticAlgorithm.BestCandidate)
+	}
+}
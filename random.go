@@ -0,0 +1,56 @@
+package ga
+
+import "math/rand"
+
+// Source is a rand.Source64 that produces the same values as rand.NewSource, and also counts the values it has
+// drawn, so that its state can be saved in a checkpoint and restored by replaying the draws from the seed
+type Source struct {
+	seed   int64
+	draws  uint64
+	source rand.Source64
+}
+
+// SourceState is the serialisable state of a Source
+type SourceState struct {
+	Seed  int64
+	Draws uint64
+}
+
+// NewSource returns a Source seeded with seed
+func NewSource(seed int64) *Source {
+	source := &Source{}
+	source.Seed(seed)
+	return source
+}
+
+// Seed resets the Source to the state given by seed
+func (source *Source) Seed(seed int64) {
+	source.seed = seed
+	source.draws = 0
+	source.source = rand.NewSource(seed).(rand.Source64)
+}
+
+// Int63 returns a non-negative pseudo-random 63-bit integer
+func (source *Source) Int63() int64 {
+	source.draws++
+	return source.source.Int63()
+}
+
+// Uint64 returns a pseudo-random 64-bit value
+func (source *Source) Uint64() uint64 {
+	source.draws++
+	return source.source.Uint64()
+}
+
+// State returns the seed of the Source and the number of values drawn from it
+func (source *Source) State() SourceState {
+	return SourceState{source.seed, source.draws}
+}
+
+// SetState restores a state previously returned by State
+func (source *Source) SetState(state SourceState) {
+	source.Seed(state.Seed)
+	for source.draws < state.Draws {
+		source.Int63()
+	}
+}
@@ -0,0 +1,37 @@
+package ga
+
+import (
+	"math/rand"
+	"testing"
+)
+
+func TestSource(t *testing.T) {
+	t.Parallel()
+	expected := rand.New(rand.NewSource(3))
+	got := rand.New(NewSource(3))
+	for i := 0; i < 100; i++ {
+		if e, g := expected.Intn(1000), got.Intn(1000); e != g {
+			t.Fatal("Source differs from rand.NewSource.", "Expected:", e, "Got:", g)
+		}
+	}
+	t.Log("Source matches rand.NewSource.")
+}
+
+func TestSource_SetState(t *testing.T) {
+	t.Parallel()
+	source := NewSource(3)
+	random := rand.New(source)
+	for i := 0; i < 50; i++ {
+		random.Float64()
+	}
+	state := source.State()
+	expected := random.Int63()
+
+	restored := NewSource(0)
+	restored.SetState(state)
+	if got := restored.Int63(); got != expected {
+		t.Error("Restored source produced a different value.", "Expected:", expected, "Got:", got)
+	} else {
+		t.Log("Restored source continued the sequence.", "Expected:", expected, "Got:", got)
+	}
+}
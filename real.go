@@ -0,0 +1,223 @@
+package ga
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+)
+
+// RealVector is a sequence of continuous parameters
+type RealVector = Sequence[float64]
+
+// Bound is the closed interval a single parameter of a RealVector may take
+type Bound struct {
+	Lower float64
+	Upper float64
+}
+
+// Bounds holds the Bound of each dimension of a RealVector
+type Bounds []Bound
+
+// UniformBounds returns Bounds of the given number of dimensions, all between lower and upper
+func UniformBounds(dimensions int, lower, upper float64) Bounds {
+	bounds := make(Bounds, dimensions)
+	for i := range bounds {
+		bounds[i] = Bound{lower, upper}
+	}
+	return bounds
+}
+
+// Validate returns an error if there are no dimensions, or any Bound is not finite or has Lower above Upper
+func (bounds Bounds) Validate() error {
+	if len(bounds) == 0 {
+		return errors.New("bounds have no dimensions")
+	}
+	for i, val := range bounds {
+		if math.IsNaN(val.Lower) || math.IsNaN(val.Upper) || math.IsInf(val.Lower, 0) || math.IsInf(val.Upper, 0) {
+			return fmt.Errorf("bound %v is not finite", i)
+		}
+		if val.Lower > val.Upper {
+			return fmt.Errorf("bound %v has lower %v above upper %v", i, val.Lower, val.Upper)
+		}
+	}
+	return nil
+}
+
+// Contains reports whether every parameter of vector is within its Bound
+func (bounds Bounds) Contains(vector RealVector) bool {
+	if len(vector) != len(bounds) {
+		return false
+	}
+	for i, val := range vector {
+		if val < bounds[i].Lower || val > bounds[i].Upper {
+			return false
+		}
+	}
+	return true
+}
+
+// Clamp moves every parameter of vector that is outside its Bound to the nearest end of the Bound
+func (bounds Bounds) Clamp(vector RealVector) {
+	for i := range vector {
+		vector[i] = math.Max(bounds[i].Lower, math.Min(bounds[i].Upper, vector[i]))
+	}
+}
+
+// check returns an error if vector does not have one parameter per dimension
+func (bounds Bounds) check(vector RealVector) error {
+	if len(vector) != len(bounds) {
+		return fmt.Errorf("vector has %v dimensions, bounds have %v", len(vector), len(bounds))
+	}
+	return nil
+}
+
+// RealGenerateCandidate returns a generator of RealVectors drawn uniformly from within bounds.
+// The requested length must match the number of dimensions of bounds
+func RealGenerateCandidate(bounds Bounds) GenerateCandidateFunctionOf[float64] {
+	return func(length int, random *rand.Rand) (RealVector, error) {
+		if length != len(bounds) {
+			return nil, fmt.Errorf("length %v does not match the %v dimensions of bounds", length, len(bounds))
+		}
+		vector := make(RealVector, length)
+		for i, val := range bounds {
+			vector[i] = val.Lower + random.Float64()*(val.Upper-val.Lower)
+		}
+		return vector, nil
+	}
+}
+
+// BLXCrossover returns a blend crossover. Each parameter of a child is drawn uniformly from the interval spanned
+// by the parents' parameters, extended by alpha times its width on each side and limited to the bounds
+func BLXCrossover(bounds Bounds, alpha float64) CrossoverFunctionOf[float64] {
+	return func(gene, spouse GenomeOf[float64], random *rand.Rand) (PopulationOf[float64], error) {
+		if err := bounds.check(gene.Sequence); err != nil {
+			return nil, err
+		}
+		if err := bounds.check(spouse.Sequence); err != nil {
+			return nil, err
+		}
+		children := PopulationOf[float64]{{Sequence: make(RealVector, len(bounds))}, {Sequence: make(RealVector, len(bounds))}}
+		for i, val := range bounds {
+			lower := math.Min(gene.Sequence[i], spouse.Sequence[i])
+			upper := math.Max(gene.Sequence[i], spouse.Sequence[i])
+			extension := alpha * (upper - lower)
+			lower = math.Max(val.Lower, lower-extension)
+			upper = math.Min(val.Upper, upper+extension)
+			for _, child := range children {
+				child.Sequence[i] = lower + random.Float64()*(upper-lower)
+			}
+		}
+		return children, nil
+	}
+}
+
+// SBXCrossover returns a bounded simulated binary crossover with distribution index eta. Larger values of eta
+// produce children closer to their parents. Each parameter is crossed over with probability 0.5
+func SBXCrossover(bounds Bounds, eta float64) CrossoverFunctionOf[float64] {
+	// spread returns the spread factor for a child limited to distance beyond the parents
+	spread := func(u, distance float64) float64 {
+		alpha := 2 - math.Pow(1+2*distance, -(eta+1))
+		if u <= 1/alpha {
+			return math.Pow(u*alpha, 1/(eta+1))
+		}
+		return math.Pow(1/(2-u*alpha), 1/(eta+1))
+	}
+	return func(gene, spouse GenomeOf[float64], random *rand.Rand) (PopulationOf[float64], error) {
+		if err := bounds.check(gene.Sequence); err != nil {
+			return nil, err
+		}
+		if err := bounds.check(spouse.Sequence); err != nil {
+			return nil, err
+		}
+		children := PopulationOf[float64]{gene.Copy(), spouse.Copy()}
+		for i, val := range bounds {
+			x1, x2 := gene.Sequence[i], spouse.Sequence[i]
+			if random.Float64() > 0.5 || math.Abs(x1-x2) < 1e-14 {
+				continue
+			}
+			y1, y2 := math.Min(x1, x2), math.Max(x1, x2)
+			u := random.Float64()
+			c1 := 0.5 * (y1 + y2 - spread(u, (y1-val.Lower)/(y2-y1))*(y2-y1))
+			c2 := 0.5 * (y1 + y2 + spread(u, (val.Upper-y2)/(y2-y1))*(y2-y1))
+			c1 = math.Max(val.Lower, math.Min(val.Upper, c1))
+			c2 = math.Max(val.Lower, math.Min(val.Upper, c2))
+			if random.Float64() < 0.5 {
+				c1, c2 = c2, c1
+			}
+			children[0].Sequence[i], children[1].Sequence[i] = c1, c2
+		}
+		return children, nil
+	}
+}
+
+// mutationProbability returns probability, or one over length if it is not positive
+func mutationProbability(length int, probability float64) float64 {
+	if probability <= 0 {
+		return 1 / float64(length)
+	}
+	return probability
+}
+
+// GaussianMutation returns a mutate function that adds normally distributed noise to each parameter with the
+// given probability, or one over the number of dimensions if it is not positive. The standard deviation is sigma
+// times the width of the parameter's Bound, and results are clamped to the bounds
+func GaussianMutation(bounds Bounds, sigma, probability float64) MutateErrFunctionOf[float64] {
+	probability = mutationProbability(len(bounds), probability)
+	return func(gene GenomeOf[float64], random *rand.Rand) (GenomeOf[float64], error) {
+		if err := bounds.check(gene.Sequence); err != nil {
+			return GenomeOf[float64]{}, err
+		}
+		gene = gene.Copy()
+		for i, val := range bounds {
+			if random.Float64() < probability {
+				gene.Sequence[i] += random.NormFloat64() * sigma * (val.Upper - val.Lower)
+			}
+		}
+		bounds.Clamp(gene.Sequence)
+		return gene, nil
+	}
+}
+
+// PolynomialMutation returns a bounded polynomial mutation with distribution index eta, applied to each parameter
+// with the given probability, or one over the number of dimensions if it is not positive. Larger values of eta
+// produce smaller changes
+func PolynomialMutation(bounds Bounds, eta, probability float64) MutateErrFunctionOf[float64] {
+	probability = mutationProbability(len(bounds), probability)
+	return func(gene GenomeOf[float64], random *rand.Rand) (GenomeOf[float64], error) {
+		if err := bounds.check(gene.Sequence); err != nil {
+			return GenomeOf[float64]{}, err
+		}
+		gene = gene.Copy()
+		for i, val := range bounds {
+			width := val.Upper - val.Lower
+			if width == 0 || random.Float64() >= probability {
+				continue
+			}
+			y := gene.Sequence[i]
+			u := random.Float64()
+			var delta float64
+			if u < 0.5 {
+				xy := 1 - (y-val.Lower)/width
+				delta = math.Pow(2*u+(1-2*u)*math.Pow(xy, eta+1), 1/(eta+1)) - 1
+			} else {
+				xy := 1 - (val.Upper-y)/width
+				delta = 1 - math.Pow(2*(1-u)+2*(u-0.5)*math.Pow(xy, eta+1), 1/(eta+1))
+			}
+			gene.Sequence[i] = y + delta*width
+		}
+		bounds.Clamp(gene.Sequence)
+		return gene, nil
+	}
+}
+
+// NewRealGeneticAlgorithm returns a GA over RealVectors within bounds, using uniform initialisation, SBX crossover,
+// polynomial mutation and tournament selection. The fitness function must be set before running it,
+// and runs must use a length equal to the number of dimensions of bounds
+func NewRealGeneticAlgorithm(bounds Bounds) GeneticAlgorithmOf[float64] {
+	geneticAlgorithm := NewGeneticAlgorithmOf[float64]()
+	geneticAlgorithm.SetGenerateCandidate(RealGenerateCandidate(bounds))
+	geneticAlgorithm.SetCrossoverFunc(SBXCrossover(bounds, 15))
+	geneticAlgorithm.SetMutateErrFunc(PolynomialMutation(bounds, 20, 0))
+	return geneticAlgorithm
+}
@@ -0,0 +1,138 @@
+package ga
+
+import (
+	"math"
+	"math/rand"
+	"testing"
+)
+
+var testBounds = Bounds{{-5, 5}, {0, 1}, {2, 2}, {-100, -99}}
+
+func TestBounds_Validate(t *testing.T) {
+	t.Parallel()
+	if err := testBounds.Validate(); err != nil {
+		t.Error("Valid bounds rejected. Got:", err)
+	}
+	invalid := map[string]Bounds{
+		"Empty":    {},
+		"Reversed": {{1, 0}},
+		"NaN":      {{math.NaN(), 1}},
+		"Infinite": {{0, math.Inf(1)}},
+	}
+	for name, bounds := range invalid {
+		if err := bounds.Validate(); err == nil {
+			t.Error("Invalid bounds accepted:", name)
+		} else {
+			t.Log("Invalid bounds rejected:", name, "Got:", err)
+		}
+	}
+}
+
+func TestRealOperatorsRespectBounds(t *testing.T) {
+	t.Parallel()
+	random := rand.New(rand.NewSource(3))
+	generate := RealGenerateCandidate(testBounds)
+	crossovers := map[string]CrossoverFunctionOf[float64]{
+		"BLX": BLXCrossover(testBounds, 0.5),
+		"SBX": SBXCrossover(testBounds, 2),
+	}
+	mutations := map[string]MutateErrFunctionOf[float64]{
+		"Gaussian":   GaussianMutation(testBounds, 1, 1),
+		"Polynomial": PolynomialMutation(testBounds, 1, 1),
+	}
+
+	for i := 0; i < 1000; i++ {
+		vector1, err := generate(len(testBounds), random)
+		if err != nil {
+			t.Fatal("Generator errored unexpectedly. Got:", err)
+		}
+		vector2, _ := generate(len(testBounds), random)
+		if !testBounds.Contains(vector1) {
+			t.Fatal("Generated vector outside bounds. Got:", vector1)
+		}
+		parent1, parent2 := GenomeOf[float64]{Sequence: vector1}, GenomeOf[float64]{Sequence: vector2}
+		for name, crossover := range crossovers {
+			children, err := crossover(parent1, parent2, random)
+			if err != nil {
+				t.Fatal(name, "errored unexpectedly. Got:", err)
+			}
+			for _, child := range children {
+				if !testBounds.Contains(child.Sequence) {
+					t.Fatal(name, "produced a child outside bounds. Got:", child)
+				}
+			}
+		}
+		for name, mutate := range mutations {
+			mutated, err := mutate(parent1, random)
+			if err != nil {
+				t.Fatal(name, "errored unexpectedly. Got:", err)
+			}
+			if !testBounds.Contains(mutated.Sequence) {
+				t.Fatal(name, "produced a vector outside bounds. Got:", mutated)
+			}
+		}
+	}
+	t.Log("Every operator kept its results within bounds")
+}
+
+func TestRealOperatorsDimensions(t *testing.T) {
+	t.Parallel()
+	random := rand.New(rand.NewSource(3))
+	short := GenomeOf[float64]{Sequence: RealVector{0}}
+	if _, err := RealGenerateCandidate(testBounds)(2, random); err == nil {
+		t.Error("Generator accepted a length that does not match the bounds")
+	}
+	if _, err := BLXCrossover(testBounds, 0.5)(short, short, random); err == nil {
+		t.Error("BLX accepted vectors that do not match the bounds")
+	}
+	if _, err := SBXCrossover(testBounds, 2)(short, short, random); err == nil {
+		t.Error("SBX accepted vectors that do not match the bounds")
+	}
+	if _, err := GaussianMutation(testBounds, 0.1, 0)(short, random); err == nil {
+		t.Error("Gaussian mutation accepted a vector that does not match the bounds")
+	}
+	if _, err := PolynomialMutation(testBounds, 20, 0)(short, random); err == nil {
+		t.Error("Polynomial mutation accepted a vector that does not match the bounds")
+	}
+}
+
+func TestBLXCrossover_Identical(t *testing.T) {
+	t.Parallel()
+	random := rand.New(rand.NewSource(3))
+	parent := GenomeOf[float64]{Sequence: RealVector{1, 0.5, 2, -99.5}}
+	children, _ := BLXCrossover(testBounds, 0.5)(parent, parent, random)
+	for _, child := range children {
+		if child.Sequence.String() != parent.Sequence.String() {
+			t.Error("Crossover of identical parents changed them.", "Expected:", parent.Sequence, "Got:", child.Sequence)
+		}
+	}
+}
+
+func TestNewRealGeneticAlgorithm(t *testing.T) {
+	t.Parallel()
+	bounds := UniformBounds(5, -5.12, 5.12)
+	var geneticAlgorithm = NewRealGeneticAlgorithm(bounds)
+	geneticAlgorithm.SetSeed(3)
+	geneticAlgorithm.SetOutputFunc(func(a ...interface{}) {})
+	geneticAlgorithm.SetObjective(Minimise)
+	geneticAlgorithm.SetFitnessFunc(func(gene GenomeOf[float64]) float64 {
+		sum := 0.0
+		for _, val := range gene.Sequence {
+			sum += val * val
+		}
+		return sum
+	})
+
+	if err := geneticAlgorithm.Run(40, len(bounds), 200, true, true, false); err != nil {
+		t.Fatal("GA errored unexpectedly. Got:", err)
+	}
+	expectedFitness := 0.05
+	if gotFitness := geneticAlgorithm.BestCandidate.Fitness; gotFitness > expectedFitness {
+		t.Error("GA did not minimise the sphere function.", "Expected at most:", expectedFitness, "Got:", gotFitness)
+	} else {
+		t.Log("GA minimised the sphere function.", "Expected at most:", expectedFitness, "Got:", geneticAlgorithm.BestCandidate)
+	}
+	if !bounds.Contains(geneticAlgorithm.BestCandidate.Sequence) {
+		t.Error("Best candidate outside bounds. Got:", geneticAlgorithm.BestCandidate)
+	}
+}
@@ -19,8 +19,29 @@ func (r Rule) String() string {
 	return fmt.Sprintf("%v %v", r.Condition, r.Output)
 }
 
+// RuleAlphabets returns the Alphabets of an encoded RuleBase of numRules rules, each a ternary condition of
+// conditionLength followed by a binary output of outputLength
+func RuleAlphabets(numRules, conditionLength, outputLength int) Alphabets[string] {
+	alphabets := make(Alphabets[string], 0, numRules*(conditionLength+outputLength))
+	for i := 0; i < numRules; i++ {
+		alphabets = append(alphabets, UniformAlphabets[string](conditionLength, TernaryAlphabet)...)
+		alphabets = append(alphabets, UniformAlphabets[string](outputLength, BinaryAlphabet)...)
+	}
+	return alphabets
+}
+
+// setDefaultRuleFuncs sets the default rule-induction functions, which validate requires when T is string.
+// It does nothing for other gene types
+func (genA *GeneticAlgorithmOf[T]) setDefaultRuleFuncs() {
+	if bitstringGA, bitstring := any(genA).(*GeneticAlgorithm); bitstring {
+		bitstringGA.SetRulesMatchFunc(DefaultRulesMatchFunc)
+		bitstringGA.SetEncodeRulesFunc(DefaultEncodeRulesFunc)
+		bitstringGA.SetDecodeRulesFunc(DefaultDecodeRulesFunc)
+	}
+}
+
 // SetMutateFunc changes the mutate function to the function specified
-func (genA *GeneticAlgorithm) SetRulesMatchFunc(f RulesMatchFunc) {
+func (genA *GeneticAlgorithmOf[T]) SetRulesMatchFunc(f RulesMatchFunc) {
 	genA.RulesMatch = f
 }
 
@@ -42,11 +63,19 @@ var DefaultRulesMatchFunc RulesMatchFunc = func(rule1, rule2 Rule) (bool, error)
 }
 
 // SetMutateFunc changes the mutate function to the function specified
-func (genA *GeneticAlgorithm) SetDecodeRulesFunc(f DecodeRulesFunc) {
+func (genA *GeneticAlgorithmOf[T]) SetDecodeRulesFunc(f DecodeRulesFunc) {
 	genA.DecodeRules = f
 }
 
+// DefaultDecodeRulesFunc splits sequence into rules of ruleLength genes, each a condition of conditionLength genes
+// followed by its output. A sequence that is not a whole number of rules is an error, not a panic
 var DefaultDecodeRulesFunc DecodeRulesFunc = func(sequence Bitstring, conditionLength, ruleLength int) (RuleBase, error) {
+	if conditionLength < 0 || conditionLength >= ruleLength {
+		return nil, fmt.Errorf("condition length %v does not fit in rule length %v", conditionLength, ruleLength)
+	}
+	if len(sequence)%ruleLength != 0 {
+		return nil, fmt.Errorf("sequence length %v is not a multiple of rule length %v", len(sequence), ruleLength)
+	}
 	NewRuleBase := make([]Rule, 0)
 	for i := 0; i < len(sequence); i += ruleLength {
 		condition := make(Bitstring, len(sequence[i:i+conditionLength]))
@@ -58,7 +87,7 @@ var DefaultDecodeRulesFunc DecodeRulesFunc = func(sequence Bitstring, conditionL
 }
 
 // SetMutateFunc changes the mutate function to the function specified
-func (genA *GeneticAlgorithm) SetEncodeRulesFunc(f EncodeRulesFunc) {
+func (genA *GeneticAlgorithmOf[T]) SetEncodeRulesFunc(f EncodeRulesFunc) {
 	genA.EncodeRules = f
 }
 
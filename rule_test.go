@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/rand"
 	"os"
+	"strings"
 	"testing"
 )
 
@@ -17,7 +18,7 @@ func TestSetRulesMatchFunc(t *testing.T) {
 	genA.SetOutputFunc(func(a ...interface{}) { t.Log(a...) })
 
 	genA.SetMutateFunc(func(gene Genome, random *rand.Rand) Genome {
-		return Genome{Bitstring{"1", "2", "3", "4"}}
+		return Genome{Sequence: Bitstring{"1", "2", "3", "4"}}
 	})
 
 	output := fmt.Sprint(genA.Mutate(Genome{}, genA.RandomEngine))
@@ -32,7 +33,7 @@ func TestSetRulesMatchFunc(t *testing.T) {
 func TestDefaultRulesMatchFunc(t *testing.T) {
 	t.Parallel()
 	genA := NewGeneticAlgorithm()
-	gene := Genome{Bitstring{"1", "0", "1", "0", "1"}}
+	gene := Genome{Sequence: Bitstring{"1", "0", "1", "0", "1"}}
 	geneOutput := genA.Mutate(gene, genA.RandomEngine)
 
 	if gene.String() == geneOutput.String() {
@@ -152,6 +153,44 @@ func TestRule_DefaultRulesMatch(t *testing.T) {
 	})
 }
 
+func TestNewGeneticAlgorithmOf_RuleFuncs(t *testing.T) {
+	t.Parallel()
+	var geneticAlgorithm = NewGeneticAlgorithmOf[string]()
+	geneticAlgorithm.SetGenerateCandidate(DefaultGenerateCandidate)
+	geneticAlgorithm.SetMutateFunc(DefaultMutateFunc)
+	geneticAlgorithm.SetFitnessFunc(DefaultFitnessFunc)
+	if err := geneticAlgorithm.validate(NewRunConfig(10, 10, 10)); err != nil {
+		t.Error("Generic bitstring GA is missing its rule functions. Got:", err)
+	} else {
+		t.Log("Generic bitstring GA has the default rule functions")
+	}
+}
+
+func TestDefaultDecodeRulesFunc(t *testing.T) {
+	t.Parallel()
+	sequence := Bitstring{"0", "#", "1", "1", "#", "0"}
+	rules, err := DefaultDecodeRulesFunc(sequence, 2, 3)
+	if err != nil {
+		t.Fatal("Decode errored unexpectedly. Got:", err)
+	}
+	expected := "[[0 # ] 1 [1 # ] 0]"
+	if got := fmt.Sprint(rules); got != expected {
+		t.Error("Incorrect rules decoded.", "Expected:", expected, "Got:", got)
+	}
+	if _, err := DefaultDecodeRulesFunc(sequence[:5], 2, 3); err == nil {
+		t.Error("Decoded a sequence that is not a whole number of rules")
+	}
+	if _, err := DefaultDecodeRulesFunc(sequence, 3, 3); err == nil {
+		t.Error("Decoded rules with no room for an output")
+	}
+	if _, err := DefaultDecodeRulesFunc(sequence, -1, 3); err == nil {
+		t.Error("Decoded rules with a negative condition length")
+	}
+	if _, err := DefaultDecodeRulesFunc(sequence, 0, 0); err == nil {
+		t.Error("Decoded rules of no length")
+	}
+}
+
 func TestRuleGA(t *testing.T) {
 	var geneticAlgorithm = NewGeneticAlgorithm()
 	geneticAlgorithm.SetSeed(3)
@@ -177,34 +216,38 @@ func TestRuleGA(t *testing.T) {
 		ruleSequence := make(Bitstring, 0)
 		for char := 0; char < conditionLength; char++ {
 			num := string(text[char])
-			check(err)
 			ruleSequence = append(ruleSequence, num)
 		}
 		output := string(text[conditionLength+1:])
-		check(err)
 		InputRuleBase = append(InputRuleBase, Rule{ruleSequence, output})
 	}
 
-	geneticAlgorithm.SetFitnessFunc(func(gene Genome) int {
+	geneticAlgorithm.SetFitnessErrFunc(func(gene Genome) (float64, error) {
 		fitnessValue := 0
 		NewRuleBase, err := geneticAlgorithm.DecodeRules(gene.Sequence, conditionLength, ruleLength)
-		check(err)
+		if err != nil {
+			return 0, err
+		}
 		for _, InputRule := range InputRuleBase {
 			for _, GeneratedRule := range NewRuleBase {
 				matches, err := geneticAlgorithm.RulesMatch(InputRule, GeneratedRule)
-				check(err)
+				if err != nil {
+					return 0, err
+				}
 				if matches {
 					fitnessValue++
 					break
 				}
 			}
 		}
-		return fitnessValue
+		return float64(fitnessValue), nil
 	})
 
-	geneticAlgorithm.SetMutateFunc(func(gene Genome, random *rand.Rand) Genome {
+	geneticAlgorithm.SetMutateErrFunc(func(gene Genome, random *rand.Rand) (Genome, error) {
 		NewRuleBase, err := geneticAlgorithm.DecodeRules(gene.Sequence, conditionLength, ruleLength)
-		check(err)
+		if err != nil {
+			return Genome{}, err
+		}
 		gene = gene.Copy()
 		for rule := range NewRuleBase {
 			chance := random.Int() % 100
@@ -221,16 +264,21 @@ func TestRuleGA(t *testing.T) {
 			}
 		}
 		sequence, err := geneticAlgorithm.EncodeRules(NewRuleBase)
-		check(err)
-		return Genome{sequence}
+		if err != nil {
+			return Genome{}, err
+		}
+		return Genome{Sequence: sequence}, nil
 	})
 
-	geneticAlgorithm.Run(20, numRules*ruleLength, 20, true, true, false)
-	geneticAlgorithm.Output(geneticAlgorithm.BestCandidate, geneticAlgorithm.Candidates)
+	if err := geneticAlgorithm.Run(20, numRules*ruleLength, 20, true, true, false); err != nil {
+		t.Fatal("GA errored unexpectedly. Got:", err)
+	}
+	geneticAlgorithm.Logger.Info("result", "best", geneticAlgorithm.BestCandidate, "candidates", geneticAlgorithm.Candidates)
 
-	geneticAlgorithm.Output(geneticAlgorithm.DecodeRules(geneticAlgorithm.BestCandidate.Sequence, conditionLength, ruleLength))
+	decoded, _ := geneticAlgorithm.DecodeRules(geneticAlgorithm.BestCandidate.Sequence, conditionLength, ruleLength)
+	geneticAlgorithm.Logger.Info("rules", "decoded", decoded)
 
-	expectedFitness := 26
+	expectedFitness := 26.0
 	gotFitness := geneticAlgorithm.Fitness(geneticAlgorithm.BestCandidate)
 
 	if gotFitness < expectedFitness {
@@ -239,3 +287,64 @@ func TestRuleGA(t *testing.T) {
 		t.Log("GA produced a suitable candidate.", "Expected at least:", expectedFitness, "Got:", gotFitness)
 	}
 }
+
+func TestRuleGA_Alphabets(t *testing.T) {
+	t.Parallel()
+	var geneticAlgorithm = NewGeneticAlgorithm()
+	geneticAlgorithm.SetSeed(3)
+	geneticAlgorithm.SetOutputFunc(func(a ...interface{}) {})
+
+	conditionLength := 5
+	outputLength := 1
+	ruleLength := conditionLength + outputLength
+	numRules := 32
+
+	data, err := os.ReadFile("data/data1.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	inputRules := make([]Rule, 0)
+	for _, line := range strings.Split(strings.TrimSpace(string(data)), "\n")[1:] {
+		condition := make(Bitstring, 0, conditionLength)
+		for _, char := range line[:conditionLength] {
+			condition = append(condition, string(char))
+		}
+		inputRules = append(inputRules, Rule{condition, strings.TrimSpace(line[conditionLength+1:])})
+	}
+
+	geneticAlgorithm.SetFitnessErrFunc(func(gene Genome) (float64, error) {
+		rules, err := geneticAlgorithm.DecodeRules(gene.Sequence, conditionLength, ruleLength)
+		if err != nil {
+			return 0, err
+		}
+		fitnessValue := 0
+		for _, inputRule := range inputRules {
+			for _, rule := range rules {
+				if matches, err := geneticAlgorithm.RulesMatch(inputRule, rule); err != nil {
+					return 0, err
+				} else if matches {
+					fitnessValue++
+					break
+				}
+			}
+		}
+		return float64(fitnessValue), nil
+	})
+	alphabets := RuleAlphabets(numRules, conditionLength, outputLength)
+	geneticAlgorithm.SetGenerateCandidate(AlphabetGenerateCandidate(alphabets))
+	geneticAlgorithm.SetMutateErrFunc(UniformAlphabetMutation(alphabets, 0))
+
+	if err := geneticAlgorithm.Run(20, numRules*ruleLength, 20, true, true, false); err != nil {
+		t.Fatal("GA errored unexpectedly. Got:", err)
+	}
+
+	if !alphabets.Contains(geneticAlgorithm.BestCandidate.Sequence) {
+		t.Error("Best candidate has genes outside their alphabets. Got:", geneticAlgorithm.BestCandidate)
+	}
+	expectedFitness := 26.0
+	if gotFitness := geneticAlgorithm.BestCandidate.Fitness; gotFitness < expectedFitness {
+		t.Error("GA did not produce a suitable candidate.", "Expected at least:", expectedFitness, "Got:", gotFitness)
+	} else {
+		t.Log("GA produced a suitable candidate.", "Expected at least:", expectedFitness, "Got:", gotFitness)
+	}
+}
@@ -1,13 +1,25 @@
 package ga
 
 import (
+	"math"
 	"math/rand"
+	"sort"
 )
 
-type SelectFunction func(FitnessFunction, Population, *rand.Rand) Population
+// SelectFunctionOf picks a breeding pool from a population. Higher fitness is always better,
+// the GA passes a fitness function that reads each Genome's stored fitness, negated when minimising
+type SelectFunctionOf[T comparable] func(FitnessFunctionOf[T], PopulationOf[T], *rand.Rand) PopulationOf[T]
 
-var TournamentSelection SelectFunction = func(Fitness FitnessFunction, candidatePool Population, random *rand.Rand) Population {
-	offspring := make(Population, 0)
+// SelectFunction picks a breeding pool of bitstrings
+type SelectFunction = SelectFunctionOf[string]
+
+var TournamentSelection SelectFunction = Tournament[string]
+
+var RouletteSelection SelectFunction = Roulette[string]
+
+// Tournament picks the fitter of two random candidates for every place in the breeding pool
+func Tournament[T comparable](Fitness FitnessFunctionOf[T], candidatePool PopulationOf[T], random *rand.Rand) PopulationOf[T] {
+	offspring := make(PopulationOf[T], 0)
 
 	for i := 0; i < len(candidatePool); i++ {
 		parent1 := candidatePool[random.Int()%len(candidatePool)]
@@ -23,26 +35,65 @@ var TournamentSelection SelectFunction = func(Fitness FitnessFunction, candidate
 	return offspring
 }
 
-var RouletteSelection SelectFunction = func(Fitness FitnessFunction, candidatePool Population, random *rand.Rand) Population {
-	offspring := make(Population, 0)
+// Roulette picks candidates with probability proportional to fitness.
+// When any fitness is negative, weights are shifted so that the least fit candidate has zero weight
+func Roulette[T comparable](Fitness FitnessFunctionOf[T], candidatePool PopulationOf[T], random *rand.Rand) PopulationOf[T] {
+	weights := make([]float64, len(candidatePool))
+	minWeight := 0.0
+	for i, val := range candidatePool {
+		weights[i] = Fitness(val)
+		minWeight = math.Min(minWeight, weights[i])
+	}
+	weightSum := 0.0
+	for i := range weights {
+		weights[i] -= minWeight
+		weightSum += weights[i]
+	}
+
+	offspring := make(PopulationOf[T], 0)
 	for range candidatePool {
-		weightSum := 0
-		for _, val := range candidatePool {
-			weightSum += Fitness(val)
+		if weightSum == 0 {
+			offspring = append(offspring, candidatePool[random.Int()%len(candidatePool)].duplicate())
+			continue
 		}
-		choice := random.Float32() * float32(weightSum)
-		for _, val := range candidatePool {
-			choice -= float32(Fitness(val))
-			if choice <= 0 {
-				offspring = append(offspring, val.Copy())
+		choice := random.Float64() * weightSum
+		index := 0
+		for ; index < len(weights)-1; index++ {
+			if choice < weights[index] {
 				break
 			}
+			choice -= weights[index]
 		}
+		offspring = append(offspring, candidatePool[index].duplicate())
 	}
 	return offspring
 }
 
 // SetSelectionFunc changes the selection function to the function specified
-func (genA *GeneticAlgorithm) SetSelectionFunc(f SelectFunction) {
+func (genA *GeneticAlgorithmOf[T]) SetSelectionFunc(f SelectFunctionOf[T]) {
 	genA.Selection = f
 }
+
+// Elites returns copies of the count fittest candidates in candidatePool, fittest first
+func (genA *GeneticAlgorithmOf[T]) Elites(candidatePool PopulationOf[T], count int) PopulationOf[T] {
+	if count > len(candidatePool) {
+		count = len(candidatePool)
+	}
+	fitness := make([]float64, len(candidatePool))
+	order := make([]int, len(candidatePool))
+	for i, val := range candidatePool {
+		fitness[i] = genA.evaluate(val)
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		return genA.better(fitness[order[i]], fitness[order[j]])
+	})
+	elites := make(PopulationOf[T], 0, count)
+	for _, index := range order[:count] {
+		elite := candidatePool[index].Copy()
+		elite.Fitness = fitness[index]
+		elite.Evaluated = true
+		elites = append(elites, elite)
+	}
+	return elites
+}
@@ -1,6 +1,7 @@
 package ga
 
 import (
+	"fmt"
 	"math/rand"
 	"testing"
 )
@@ -20,14 +21,14 @@ func TestSetSelectionFunc(t *testing.T) {
 	})
 
 	genA.Candidates = Population{
-		{Bitstring{"1", "1", "1", "1"}},
-		{Bitstring{"0", "1", "1", "1"}},
-		{Bitstring{"0", "0", "1", "1"}},
-		{Bitstring{"0", "0", "0", "1"}},
+		{Sequence: Bitstring{"1", "1", "1", "1"}},
+		{Sequence: Bitstring{"0", "1", "1", "1"}},
+		{Sequence: Bitstring{"0", "0", "1", "1"}},
+		{Sequence: Bitstring{"0", "0", "0", "1"}},
 	}
 	genA.Candidates = genA.Selection(genA.Fitness, genA.Candidates, genA.RandomEngine)
 
-	expectedFitness := 4
+	expectedFitness := 4.0
 	gotFitness := genA.AverageFitness(genA.Candidates)
 	if expectedFitness != gotFitness {
 		t.Error("Set selection function did not work.", "Expected:", expectedFitness, "Got:", gotFitness)
@@ -43,10 +44,10 @@ func TestTournament(t *testing.T) {
 	genA.SetOutputFunc(func(a ...interface{}) { t.Log(a...) })
 
 	genA.Candidates = Population{
-		{Bitstring{"1", "1", "1", "1"}},
-		{Bitstring{"0", "1", "1", "1"}},
-		{Bitstring{"0", "0", "1", "1"}},
-		{Bitstring{"0", "0", "0", "1"}},
+		{Sequence: Bitstring{"1", "1", "1", "1"}},
+		{Sequence: Bitstring{"0", "1", "1", "1"}},
+		{Sequence: Bitstring{"0", "0", "1", "1"}},
+		{Sequence: Bitstring{"0", "0", "0", "1"}},
 	}
 	avgFitnessBefore := genA.AverageFitness(genA.Candidates)
 	genA.Candidates = genA.Selection(genA.Fitness, genA.Candidates, genA.RandomEngine)
@@ -66,10 +67,10 @@ func TestRoulette(t *testing.T) {
 	genA.SetOutputFunc(func(a ...interface{}) { t.Log(a...) })
 
 	genA.Candidates = Population{
-		{Bitstring{"1", "1", "1", "1"}},
-		{Bitstring{"0", "1", "1", "1"}},
-		{Bitstring{"0", "0", "1", "1"}},
-		{Bitstring{"0", "0", "0", "1"}},
+		{Sequence: Bitstring{"1", "1", "1", "1"}},
+		{Sequence: Bitstring{"0", "1", "1", "1"}},
+		{Sequence: Bitstring{"0", "0", "1", "1"}},
+		{Sequence: Bitstring{"0", "0", "0", "1"}},
 	}
 	avgFitnessBefore := genA.AverageFitness(genA.Candidates)
 	genA.Candidates = genA.Selection(genA.Fitness, genA.Candidates, genA.RandomEngine)
@@ -81,3 +82,108 @@ func TestRoulette(t *testing.T) {
 		t.Log("Average Fitness no worse after tournament.", "Was:", avgFitnessBefore, "Now:", avgFitnessAfter)
 	}
 }
+
+func TestElites(t *testing.T) {
+	t.Parallel()
+	var genA = NewGeneticAlgorithm()
+	genA.SetSeed(3)
+	genA.SetOutputFunc(func(a ...interface{}) { t.Log(a...) })
+
+	candidatePool := Population{
+		{Sequence: Bitstring{"0", "0", "1", "1"}},
+		{Sequence: Bitstring{"1", "1", "1", "1"}},
+		{Sequence: Bitstring{"0", "0", "0", "1"}},
+		{Sequence: Bitstring{"0", "1", "1", "1"}},
+	}
+	elites := genA.Elites(candidatePool, 2)
+
+	expected := "[{[1 1 1 1 ] 4} {[0 1 1 1 ] 3}]"
+	got := fmt.Sprint(elites)
+	if got != expected {
+		t.Error("Incorrect elites.", "Expected:", expected, "Got:", got)
+	} else {
+		t.Log("Correct elites.", "Expected:", expected, "Got:", got)
+	}
+
+	elites[0].Sequence[0] = "0"
+	if candidatePool[1].Sequence[0] != "1" {
+		t.Error("Elites share memory with the candidate pool.")
+	}
+}
+
+func TestElitism(t *testing.T) {
+	t.Parallel()
+	var genA = NewGeneticAlgorithm()
+	genA.SetSeed(3)
+	genA.SetOutputFunc(func(a ...interface{}) {})
+
+	config := NewRunConfig(10, 20, 20)
+	config.EliteCount = 2
+	if err := genA.RunWithConfig(config); err != nil {
+		t.Error("GA errored unexpectedly. Got:", err)
+	}
+
+	expectedFitness := genA.Fitness(genA.BestCandidate)
+	gotFitness := genA.MaxFitness(genA.Candidates)
+	if gotFitness != expectedFitness {
+		t.Error("Best candidate was lost from the population.", "Expected:", expectedFitness, "Got:", gotFitness)
+	} else {
+		t.Log("Best candidate kept in the population.", "Expected:", expectedFitness, "Got:", gotFitness)
+	}
+}
+
+func TestSelectionMinimise(t *testing.T) {
+	t.Parallel()
+	testSelection := func(name string, selection SelectFunction) {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			var genA = NewGeneticAlgorithm()
+			genA.SetSeed(3)
+			genA.SetObjective(Minimise)
+			genA.SetSelectionFunc(selection)
+
+			genA.Candidates = Population{
+				{Sequence: Bitstring{"1", "1", "1", "1"}},
+				{Sequence: Bitstring{"0", "1", "1", "1"}},
+				{Sequence: Bitstring{"0", "0", "1", "1"}},
+				{Sequence: Bitstring{"0", "0", "0", "1"}},
+			}
+			avgFitnessBefore := genA.AverageFitness(genA.Candidates)
+			for i := 0; i < 5; i++ {
+				genA.Candidates = genA.Selection(genA.objectiveFitness, genA.Candidates, genA.RandomEngine)
+			}
+			avgFitnessAfter := genA.AverageFitness(genA.Candidates)
+
+			if avgFitnessAfter >= avgFitnessBefore {
+				t.Error("Average Fitness did not decrease when minimising.", "Was:", avgFitnessBefore, "Now:", avgFitnessAfter)
+			} else {
+				t.Log("Average Fitness decreased when minimising.", "Was:", avgFitnessBefore, "Now:", avgFitnessAfter)
+			}
+		})
+	}
+	testSelection("Tournament", TournamentSelection)
+	testSelection("Roulette", RouletteSelection)
+}
+
+func TestRouletteNegativeFitness(t *testing.T) {
+	t.Parallel()
+	var genA = NewGeneticAlgorithm()
+	genA.SetSeed(3)
+	genA.SetFitnessFunc(func(gene Genome) float64 {
+		return DefaultFitnessFunc(gene) - 10
+	})
+
+	candidatePool := Population{
+		{Sequence: Bitstring{"1", "1", "1", "1"}},
+		{Sequence: Bitstring{"0", "0", "0", "0"}},
+	}
+	offspring := RouletteSelection(genA.Fitness, candidatePool, genA.RandomEngine)
+	if len(offspring) != len(candidatePool) {
+		t.Error("Roulette returned wrong number of offspring.", "Expected:", len(candidatePool), "Got:", len(offspring))
+	}
+	for _, val := range offspring {
+		if val.String() != candidatePool[0].String() {
+			t.Error("Roulette selected the least fit candidate with zero weight. Got:", val)
+		}
+	}
+}
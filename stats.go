@@ -0,0 +1,101 @@
+package ga
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// GenerationStatsOf summarises the population at the end of a generation
+type GenerationStatsOf[T comparable] struct {
+	Generation  int
+	Min         float64
+	Max         float64
+	Mean        float64
+	Median      float64
+	StdDev      float64
+	BestFitness float64
+	Best        GenomeOf[T]
+	// Unique is the number of distinct sequences in the population
+	Unique int
+	// Evaluations is the number of fitness evaluations made in the run so far
+	Evaluations int
+	// Elapsed is the time since the run was initialised
+	Elapsed time.Duration
+}
+
+// GenerationStats summarises a generation of a bitstring GA
+type GenerationStats = GenerationStatsOf[string]
+
+// Stats returns the statistics of candidatePool as the GA's current generation, evaluating any candidate
+// not yet evaluated
+func (genA *GeneticAlgorithmOf[T]) Stats(candidatePool PopulationOf[T]) GenerationStatsOf[T] {
+	return genA.stats(candidatePool, genA.EvaluatePopulation(candidatePool))
+}
+
+// evaluatedStats returns the statistics of the candidates in candidatePool that are already evaluated,
+// without calling the fitness function
+func (genA *GeneticAlgorithmOf[T]) evaluatedStats(candidatePool PopulationOf[T]) GenerationStatsOf[T] {
+	var (
+		evaluated PopulationOf[T]
+		fitness   []float64
+	)
+	for _, val := range candidatePool {
+		if val.Evaluated {
+			evaluated = append(evaluated, val)
+			fitness = append(fitness, val.Fitness)
+		}
+	}
+	return genA.stats(evaluated, fitness)
+}
+
+// stats returns the statistics of candidatePool, given the fitness of each candidate
+func (genA *GeneticAlgorithmOf[T]) stats(candidatePool PopulationOf[T], fitness []float64) GenerationStatsOf[T] {
+	stats := GenerationStatsOf[T]{
+		Generation:  genA.Generations,
+		Evaluations: genA.Evaluations,
+	}
+	if !genA.started.IsZero() {
+		stats.Elapsed = time.Since(genA.started)
+	}
+	if len(fitness) == 0 {
+		return stats
+	}
+
+	sorted := append([]float64(nil), fitness...)
+	sort.Float64s(sorted)
+	stats.Min = sorted[0]
+	stats.Max = sorted[len(sorted)-1]
+	if middle := len(sorted) / 2; len(sorted)%2 == 0 {
+		stats.Median = (sorted[middle-1] + sorted[middle]) / 2
+	} else {
+		stats.Median = sorted[middle]
+	}
+
+	for _, val := range fitness {
+		stats.Mean += val
+	}
+	stats.Mean /= float64(len(fitness))
+	for _, val := range fitness {
+		stats.StdDev += (val - stats.Mean) * (val - stats.Mean)
+	}
+	stats.StdDev = math.Sqrt(stats.StdDev / float64(len(fitness)))
+
+	best := 0
+	for i, val := range fitness {
+		if genA.better(val, fitness[best]) {
+			best = i
+		}
+	}
+	stats.BestFitness = fitness[best]
+	stats.Best = candidatePool[best].duplicate()
+	stats.Best.Fitness = fitness[best]
+	stats.Best.Evaluated = true
+
+	unique := make(map[string]bool)
+	for _, val := range candidatePool {
+		unique[cacheKey(val)] = true
+	}
+	stats.Unique = len(unique)
+	return stats
+}
@@ -0,0 +1,70 @@
+package ga
+
+import (
+	"math"
+	"testing"
+)
+
+func TestStats(t *testing.T) {
+	t.Parallel()
+	var genA = NewGeneticAlgorithm()
+
+	candidatePool := Population{
+		{Sequence: Bitstring{"1", "1", "1", "1"}},
+		{Sequence: Bitstring{"0", "1", "1", "1"}},
+		{Sequence: Bitstring{"0", "0", "1", "1"}},
+		{Sequence: Bitstring{"0", "0", "0", "1"}},
+		{Sequence: Bitstring{"0", "0", "0", "1"}},
+	}
+	stats := genA.Stats(candidatePool)
+
+	check := func(name string, expected, got float64) {
+		if math.Abs(expected-got) > 1e-9 {
+			t.Error("Incorrect", name, "Expected:", expected, "Got:", got)
+		} else {
+			t.Log("Correct", name, "Expected:", expected, "Got:", got)
+		}
+	}
+	check("min", 1, stats.Min)
+	check("max", 4, stats.Max)
+	check("mean", 2.2, stats.Mean)
+	check("median", 2, stats.Median)
+	check("standard deviation", math.Sqrt(1.36), stats.StdDev)
+	check("best fitness", 4, stats.BestFitness)
+	if stats.Best.String() != "{[1 1 1 1 ] 4}" {
+		t.Error("Incorrect best genome.", "Expected:", "{[1 1 1 1 ] 4}", "Got:", stats.Best)
+	}
+	if stats.Unique != 4 {
+		t.Error("Incorrect unique count.", "Expected:", 4, "Got:", stats.Unique)
+	}
+	if stats.Evaluations != 4 {
+		t.Error("Incorrect evaluation count.", "Expected:", 4, "Got:", stats.Evaluations)
+	}
+
+	genA.SetObjective(Minimise)
+	check("best fitness when minimising", 1, genA.Stats(candidatePool).BestFitness)
+	check("even median", 1.5, genA.Stats(candidatePool[2:4]).Median)
+}
+
+func TestHistory(t *testing.T) {
+	t.Parallel()
+	var genA = NewGeneticAlgorithm()
+	genA.SetSeed(3)
+	genA.SetOutputFunc(func(a ...interface{}) {})
+
+	if err := genA.Run(10, 10, 5, true, true, false); err != nil {
+		t.Error("GA errored unexpectedly. Got:", err)
+	}
+
+	if len(genA.History) != 6 {
+		t.Error("History not filled each generation.", "Expected:", 6, "Got:", len(genA.History))
+	}
+	for i, stats := range genA.History {
+		if stats.Generation != i {
+			t.Error("History out of order.", "Expected:", i, "Got:", stats.Generation)
+		}
+		if i > 0 && (stats.Evaluations < genA.History[i-1].Evaluations || stats.Elapsed < genA.History[i-1].Elapsed) {
+			t.Error("History counters decreased.", "Was:", genA.History[i-1], "Now:", stats)
+		}
+	}
+}
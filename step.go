@@ -0,0 +1,181 @@
+package ga
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// Init starts a new run with a random population of populationSize candidates of the given length, ready for Step.
+// The remaining parameters of the run are read from the GA's Config. History is reset to the initial population's statistics
+func (genA *GeneticAlgorithmOf[T]) Init(populationSize, length int) error {
+	genA.Config.PopulationSize = populationSize
+	genA.Config.BitstringLength = length
+	if err := genA.validate(genA.Config); err != nil {
+		return err
+	}
+
+	genA.Generations = 0
+	genA.IterationsSinceChange = 0
+	genA.Evaluations = 0
+	genA.BestCandidate = GenomeOf[T]{}
+	genA.started = time.Now()
+	genA.takeFitnessError()
+	candidates, err := genA.FillRandomPopulation(populationSize, length)
+	if err != nil {
+		return runError(0, PhaseInit, err)
+	}
+	genA.Candidates = candidates
+	genA.EvaluatePopulation(genA.Candidates)
+	if err := genA.takeFitnessError(); err != nil {
+		genA.Candidates = nil
+		return runError(0, PhaseInit, err)
+	}
+	genA.UpdateBestCandidate(genA.BestFitnessCandidate(genA.Candidates))
+	genA.History = []GenerationStatsOf[T]{genA.Stats(genA.Candidates)}
+	genA.saveGenerationState()
+	return runError(0, PhaseInit, genA.writeHistory(genA.History[0], genA.Candidates))
+}
+
+// Step runs a single generation of selection, crossover, mutation and replacement, and returns its statistics,
+// which are also appended to History
+func (genA *GeneticAlgorithmOf[T]) Step() (GenerationStatsOf[T], error) {
+	return genA.StepContext(context.Background())
+}
+
+// StepContext behaves like Step, but checks ctx between each phase and returns ctx.Err() once it is done,
+// leaving Candidates, BestCandidate, Generations and History unchanged. An error from one of the GA's functions or
+// its HistoryWriter also leaves them unchanged, and is returned as a *RunError
+func (genA *GeneticAlgorithmOf[T]) StepContext(ctx context.Context) (GenerationStatsOf[T], error) {
+	config := genA.Config
+	generation := genA.Generations + 1
+	if len(genA.Candidates) == 0 {
+		return GenerationStatsOf[T]{}, errors.New("population is not initialised")
+	}
+	if err := ctx.Err(); err != nil {
+		return GenerationStatsOf[T]{}, err
+	}
+	genA.takeFitnessError()
+
+	// Evaluation
+	genA.EvaluatePopulation(genA.Candidates)
+	if err := genA.takeFitnessError(); err != nil {
+		return GenerationStatsOf[T]{}, runError(generation, PhaseGenerationStart, err)
+	}
+	genA.Logger.Debug("generation", "generation", generation)
+	genA.Summarise("start population", genA.Candidates)
+	genA.firePhase(genA.Hooks.OnGenerationStart, PhaseGenerationStart, generation, genA.Candidates)
+
+	// Elitism
+	elites := genA.Elites(genA.Candidates, config.EliteCount)
+	if len(elites) > 0 {
+		genA.Summarise("elites", elites)
+	}
+
+	// Tournament
+	breedingGround := make(PopulationOf[T], 0)
+	breedingGround = append(breedingGround, genA.Selection(genA.objectiveFitness, genA.Candidates, genA.RandomEngine)...)
+	genA.Summarise("selection offspring", breedingGround)
+	if err := genA.takeFitnessError(); err != nil {
+		return GenerationStatsOf[T]{}, runError(generation, PhaseSelection, err)
+	}
+	genA.firePhase(genA.Hooks.OnSelection, PhaseSelection, generation, breedingGround)
+	if err := ctx.Err(); err != nil {
+		return GenerationStatsOf[T]{}, err
+	}
+
+	// Crossover
+	if config.Crossover {
+		crossoverBreedingGround := make(PopulationOf[T], 0)
+		for i := 0; i < len(breedingGround); i += 2 {
+			// With an odd number of parents the last is paired with the first, and the extra offspring dropped below
+			spouse := breedingGround[(i+1)%len(breedingGround)]
+			if config.CrossoverProbability < 1 && genA.RandomEngine.Float64() >= config.CrossoverProbability {
+				crossoverBreedingGround = append(crossoverBreedingGround, breedingGround[i].duplicate(), spouse.duplicate())
+				continue
+			}
+			newOffspring, err := genA.Crossover(breedingGround[i], spouse, genA.RandomEngine)
+			if err != nil {
+				return GenerationStatsOf[T]{}, runError(generation, PhaseCrossover, err)
+			}
+			crossoverBreedingGround = append(crossoverBreedingGround, newOffspring...)
+		}
+		if len(crossoverBreedingGround) > len(breedingGround) {
+			crossoverBreedingGround = crossoverBreedingGround[:len(breedingGround)]
+		}
+		breedingGround = crossoverBreedingGround
+		genA.EvaluatePopulation(breedingGround)
+		genA.Summarise("crossover offspring", breedingGround)
+		if err := genA.takeFitnessError(); err != nil {
+			return GenerationStatsOf[T]{}, runError(generation, PhaseCrossover, err)
+		}
+		genA.firePhase(genA.Hooks.OnCrossover, PhaseCrossover, generation, breedingGround)
+		if err := ctx.Err(); err != nil {
+			return GenerationStatsOf[T]{}, err
+		}
+	}
+
+	// Mutation
+	if config.Mutate {
+		for index := range breedingGround {
+			if config.MutationProbability > 0 {
+				breedingGround[index] = genA.mutateGenes(breedingGround[index], config.MutationProbability)
+				continue
+			}
+			mutated, err := genA.mutate(breedingGround[index])
+			if err != nil {
+				return GenerationStatsOf[T]{}, runError(generation, PhaseMutation, err)
+			}
+			breedingGround[index] = mutated
+		}
+		genA.EvaluatePopulation(breedingGround)
+		genA.Summarise("mutation offspring", breedingGround)
+		if err := genA.takeFitnessError(); err != nil {
+			return GenerationStatsOf[T]{}, runError(generation, PhaseMutation, err)
+		}
+		genA.firePhase(genA.Hooks.OnMutation, PhaseMutation, generation, breedingGround)
+		if err := ctx.Err(); err != nil {
+			return GenerationStatsOf[T]{}, err
+		}
+	}
+
+	// Replacement
+	candidates := make(PopulationOf[T], config.PopulationSize)
+	copy(candidates, append(elites, breedingGround...))
+	genA.EvaluatePopulation(candidates)
+	if err := genA.takeFitnessError(); err != nil {
+		return GenerationStatsOf[T]{}, runError(generation, PhaseGenerationEnd, err)
+	}
+	stats := genA.Stats(candidates)
+	stats.Generation = generation
+	// The generation is exported before it is committed, so that a failed export leaves the GA unchanged
+	if err := genA.writeHistory(stats, candidates); err != nil {
+		return GenerationStatsOf[T]{}, runError(generation, PhaseGenerationEnd, err)
+	}
+	genA.Generations++
+	genA.Candidates = candidates
+	genA.Summarise("final population", genA.Candidates)
+	genA.UpdateBestCandidate(genA.BestFitnessCandidate(genA.Candidates))
+	genA.IterationsSinceChange++
+	genA.History = append(genA.History, stats)
+	genA.saveGenerationState()
+	genA.firePhase(genA.Hooks.OnGenerationEnd, PhaseGenerationEnd, genA.Generations, genA.Candidates)
+
+	return stats, nil
+}
+
+// saveGenerationState records the random state at the end of a generation, which SaveCheckpoint saves alongside
+// the population so that a step cancelled part way through does not affect a resumed run
+func (genA *GeneticAlgorithmOf[T]) saveGenerationState() {
+	if genA.source != nil {
+		genA.generationState = genA.source.State()
+	}
+}
+
+// writeHistory exports stats and population to the HistoryWriter, if one is set
+func (genA *GeneticAlgorithmOf[T]) writeHistory(stats GenerationStatsOf[T], population PopulationOf[T]) error {
+	if genA.HistoryWriter == nil {
+		return nil
+	}
+	return genA.HistoryWriter.WriteGeneration(stats, population)
+}
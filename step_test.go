@@ -0,0 +1,120 @@
+package ga
+
+import (
+	"context"
+	"fmt"
+	"testing"
+)
+
+func TestStepWithoutInit(t *testing.T) {
+	t.Parallel()
+	var genA = NewGeneticAlgorithm()
+	if _, err := genA.Step(); err == nil {
+		t.Error("Step did not error on an uninitialised population")
+	} else {
+		t.Log("Step errored as expected. Got:", err)
+	}
+}
+
+func TestInitStep(t *testing.T) {
+	t.Parallel()
+	var genA = NewGeneticAlgorithm()
+	genA.SetSeed(3)
+	genA.SetOutputFunc(func(a ...interface{}) {})
+
+	if err := genA.Init(10, 10); err != nil {
+		t.Error("Init errored unexpectedly. Got:", err)
+	}
+	if len(genA.Candidates) != 10 {
+		t.Error("Init did not fill population.", "Expected:", 10, "Got:", len(genA.Candidates))
+	}
+
+	for i := 1; i <= 5; i++ {
+		stats, err := genA.Step()
+		if err != nil {
+			t.Error("Step errored unexpectedly. Got:", err)
+		}
+		if stats.Generation != i || genA.Generations != i {
+			t.Error("Generation not advanced.", "Expected:", i, "Got:", stats.Generation, genA.Generations)
+		}
+		if genA.better(stats.BestFitness, genA.BestCandidate.Fitness) {
+			t.Error("Generation best is better than the best candidate.", "Generation:", stats.BestFitness, "Best:", genA.BestCandidate.Fitness)
+		}
+		if stats.Best.Fitness != stats.BestFitness {
+			t.Error("Best genome does not match best fitness.", "Expected:", stats.BestFitness, "Got:", stats.Best)
+		}
+		t.Log("Step", i, "Best:", stats.BestFitness, "Mean:", stats.Mean)
+	}
+}
+
+func TestStepOddPopulation(t *testing.T) {
+	t.Parallel()
+	var genA = NewGeneticAlgorithm()
+	genA.SetSeed(3)
+	genA.SetOutputFunc(func(a ...interface{}) {})
+
+	if err := genA.Run(9, 10, 5, true, true, false); err != nil {
+		t.Fatal("Run errored unexpectedly. Got:", err)
+	}
+	if len(genA.Candidates) != 9 {
+		t.Error("Incorrect population size.", "Expected:", 9, "Got:", len(genA.Candidates))
+	}
+	for _, val := range genA.Candidates {
+		if len(val.Sequence) != 10 {
+			t.Error("Population holds an incomplete candidate. Got:", val)
+		}
+	}
+}
+
+func TestStepCancelled(t *testing.T) {
+	t.Parallel()
+	var genA = NewGeneticAlgorithm()
+	genA.SetSeed(3)
+	genA.SetOutputFunc(func(a ...interface{}) {})
+	if err := genA.Init(10, 10); err != nil {
+		t.Error("Init errored unexpectedly. Got:", err)
+	}
+	before := fmt.Sprint(genA.Candidates)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := genA.StepContext(ctx); err != context.Canceled {
+		t.Error("Step did not return context error.", "Expected:", context.Canceled, "Got:", err)
+	}
+	if after := fmt.Sprint(genA.Candidates); after != before || genA.Generations != 0 {
+		t.Error("Cancelled step changed the population.", "Was:", before, "Now:", after)
+	}
+}
+
+func TestStepMatchesRun(t *testing.T) {
+	t.Parallel()
+	config := NewRunConfig(10, 10, 20)
+
+	var runGA = NewGeneticAlgorithm()
+	runGA.SetSeed(3)
+	runGA.SetOutputFunc(func(a ...interface{}) {})
+	if err := runGA.RunWithConfig(config); err != nil {
+		t.Error("Run errored unexpectedly. Got:", err)
+	}
+
+	var stepGA = NewGeneticAlgorithm()
+	stepGA.SetSeed(3)
+	stepGA.SetOutputFunc(func(a ...interface{}) {})
+	stepGA.SetConfig(config)
+	if err := stepGA.Init(config.PopulationSize, config.BitstringLength); err != nil {
+		t.Error("Init errored unexpectedly. Got:", err)
+	}
+	for i := 0; i < config.Generations; i++ {
+		if _, err := stepGA.Step(); err != nil {
+			t.Error("Step errored unexpectedly. Got:", err)
+		}
+	}
+
+	expected := fmt.Sprint(runGA.BestCandidate, runGA.Candidates)
+	got := fmt.Sprint(stepGA.BestCandidate, stepGA.Candidates)
+	if got != expected {
+		t.Error("Stepping did not match Run.", "Expected:", expected, "Got:", got)
+	} else {
+		t.Log("Stepping matched Run.")
+	}
+}
@@ -0,0 +1,165 @@
+package ga
+
+import (
+	"fmt"
+	"math/rand"
+)
+
+// LengthBounds limits the length of variable-length genomes. Lengths are counted in genes and must be a multiple
+// of Unit, so that genomes made of blocks, such as encoded rules, are only cut and grown between blocks.
+// A Unit below 1 is treated as 1
+type LengthBounds struct {
+	Min  int
+	Max  int
+	Unit int
+}
+
+// unit returns the number of genes in a block
+func (bounds LengthBounds) unit() int {
+	if bounds.Unit < 1 {
+		return 1
+	}
+	return bounds.Unit
+}
+
+// Validate returns an error if Min is below one block, Max is below Min, or either is not a multiple of Unit
+func (bounds LengthBounds) Validate() error {
+	unit := bounds.unit()
+	switch {
+	case bounds.Min < unit:
+		return fmt.Errorf("minimum length %v is below one block of %v", bounds.Min, unit)
+	case bounds.Max < bounds.Min:
+		return fmt.Errorf("maximum length %v is below minimum length %v", bounds.Max, bounds.Min)
+	case bounds.Min%unit != 0 || bounds.Max%unit != 0:
+		return fmt.Errorf("length bounds %v to %v are not multiples of %v", bounds.Min, bounds.Max, unit)
+	}
+	return nil
+}
+
+// Contains reports whether length is within the bounds and a multiple of Unit
+func (bounds LengthBounds) Contains(length int) bool {
+	return length >= bounds.Min && length <= bounds.Max && length%bounds.unit() == 0
+}
+
+// check returns an error if length is not allowed by the bounds
+func (bounds LengthBounds) check(length int) error {
+	if !bounds.Contains(length) {
+		return fmt.Errorf("length %v is not a multiple of %v between %v and %v", length, bounds.unit(), bounds.Min, bounds.Max)
+	}
+	return nil
+}
+
+// VariableLengthGenerateCandidate returns a generator of genomes with a number of blocks drawn uniformly from
+// bounds, each produced by calling generate with a length of one Unit. The length requested by Run is ignored
+func VariableLengthGenerateCandidate[T comparable](bounds LengthBounds, generate GenerateCandidateFunctionOf[T]) GenerateCandidateFunctionOf[T] {
+	return func(length int, random *rand.Rand) (Sequence[T], error) {
+		if err := bounds.Validate(); err != nil {
+			return nil, err
+		}
+		unit := bounds.unit()
+		blocks := bounds.Min/unit + random.Intn((bounds.Max-bounds.Min)/unit+1)
+		sequence := make(Sequence[T], 0, blocks*unit)
+		for i := 0; i < blocks; i++ {
+			block, err := generate(unit, random)
+			if err != nil {
+				return nil, err
+			}
+			if len(block) != unit {
+				return nil, fmt.Errorf("generated block of %v genes, expected %v", len(block), unit)
+			}
+			sequence = append(sequence, block...)
+		}
+		return sequence, nil
+	}
+}
+
+// CutAndSpliceCrossover returns a crossover that cuts each parent at its own random block boundary and swaps the
+// tails, so children can differ in length from their parents. Cut points are chosen so both children stay in bounds
+func CutAndSpliceCrossover[T comparable](bounds LengthBounds) CrossoverFunctionOf[T] {
+	return func(gene, spouse GenomeOf[T], random *rand.Rand) (PopulationOf[T], error) {
+		if err := bounds.check(len(gene.Sequence)); err != nil {
+			return nil, err
+		}
+		if err := bounds.check(len(spouse.Sequence)); err != nil {
+			return nil, err
+		}
+		unit := bounds.unit()
+		geneBlocks, spouseBlocks := len(gene.Sequence)/unit, len(spouse.Sequence)/unit
+		minBlocks, maxBlocks := bounds.Min/unit, bounds.Max/unit
+
+		// Cutting the spouse at b keeps the children's lengths at a+spouseBlocks-b and b+geneBlocks-a,
+		// and b = min(a, spouseBlocks) always keeps both in bounds
+		a := random.Intn(geneBlocks + 1)
+		low := max(0, a+spouseBlocks-maxBlocks, minBlocks-geneBlocks+a)
+		high := min(spouseBlocks, a+spouseBlocks-minBlocks, maxBlocks-geneBlocks+a)
+		b := low + random.Intn(high-low+1)
+
+		a, b = a*unit, b*unit
+		return PopulationOf[T]{
+			{Sequence: append(append(make(Sequence[T], 0), gene.Sequence[:a]...), spouse.Sequence[b:]...)},
+			{Sequence: append(append(make(Sequence[T], 0), spouse.Sequence[:b]...), gene.Sequence[a:]...)},
+		}, nil
+	}
+}
+
+// IndelMutation returns a mutate function that either inserts a block produced by generate at a random block
+// boundary, or deletes a random block, with equal probability. Genomes at the minimum length always grow, and
+// genomes at the maximum length always shrink
+func IndelMutation[T comparable](bounds LengthBounds, generate GenerateCandidateFunctionOf[T]) MutateErrFunctionOf[T] {
+	return func(gene GenomeOf[T], random *rand.Rand) (GenomeOf[T], error) {
+		if err := bounds.check(len(gene.Sequence)); err != nil {
+			return GenomeOf[T]{}, err
+		}
+		gene = gene.Copy()
+		unit := bounds.unit()
+		blocks := len(gene.Sequence) / unit
+		canGrow, canShrink := len(gene.Sequence) < bounds.Max, len(gene.Sequence) > bounds.Min
+		switch {
+		case canGrow && (!canShrink || random.Intn(2) == 0):
+			block, err := generate(unit, random)
+			if err != nil {
+				return GenomeOf[T]{}, err
+			}
+			if len(block) != unit {
+				return GenomeOf[T]{}, fmt.Errorf("generated block of %v genes, expected %v", len(block), unit)
+			}
+			position := random.Intn(blocks+1) * unit
+			sequence := make(Sequence[T], 0, len(gene.Sequence)+unit)
+			sequence = append(append(append(sequence, gene.Sequence[:position]...), block...), gene.Sequence[position:]...)
+			gene.Sequence = sequence
+		case canShrink:
+			position := random.Intn(blocks) * unit
+			gene.Sequence = append(gene.Sequence[:position], gene.Sequence[position+unit:]...)
+		}
+		return gene, nil
+	}
+}
+
+// SetParsimony sets the parsimony pressure applied to fitness: coefficient times the number of genes is taken
+// from fitness when maximising, or added to it when minimising, so shorter genomes win ties. Zero disables it
+func (genA *GeneticAlgorithmOf[T]) SetParsimony(coefficient float64) {
+	genA.Parsimony = coefficient
+}
+
+// parsimony returns fitness with the parsimony penalty for gene's length applied
+func (genA *GeneticAlgorithmOf[T]) parsimony(gene GenomeOf[T], fitness float64) float64 {
+	if genA.Parsimony == 0 {
+		return fitness
+	}
+	penalty := genA.Parsimony * float64(len(gene.Sequence))
+	if genA.Objective == Minimise {
+		return fitness + penalty
+	}
+	return fitness - penalty
+}
+
+// NewVariableLengthGeneticAlgorithm returns a GA over genomes whose length varies within bounds, using
+// generate to produce blocks of one Unit for initialisation and insertion, cut-and-splice crossover,
+// insertion/deletion mutation and tournament selection. The fitness function must be set before running it
+func NewVariableLengthGeneticAlgorithm[T comparable](bounds LengthBounds, generate GenerateCandidateFunctionOf[T]) GeneticAlgorithmOf[T] {
+	geneticAlgorithm := NewGeneticAlgorithmOf[T]()
+	geneticAlgorithm.SetGenerateCandidate(VariableLengthGenerateCandidate(bounds, generate))
+	geneticAlgorithm.SetCrossoverFunc(CutAndSpliceCrossover[T](bounds))
+	geneticAlgorithm.SetMutateErrFunc(IndelMutation(bounds, generate))
+	return geneticAlgorithm
+}
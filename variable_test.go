@@ -0,0 +1,228 @@
+package ga
+
+import (
+	"bufio"
+	"math/rand"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestLengthBounds_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		bounds LengthBounds
+		valid  bool
+	}{
+		{LengthBounds{1, 10, 0}, true},
+		{LengthBounds{6, 18, 6}, true},
+		{LengthBounds{4, 4, 1}, true},
+		{LengthBounds{0, 10, 1}, false},
+		{LengthBounds{5, 4, 1}, false},
+		{LengthBounds{6, 20, 6}, false},
+	}
+	for _, test := range tests {
+		if err := test.bounds.Validate(); (err == nil) != test.valid {
+			t.Error("Incorrect validation.", "Bounds:", test.bounds, "Expected valid:", test.valid, "Got:", err)
+		}
+	}
+}
+
+// blockGenome returns a genome of the given number of blocks, where every gene of block i is start+i
+func blockGenome(start, blocks, unit int) GenomeOf[int] {
+	sequence := make(Sequence[int], 0, blocks*unit)
+	for i := 0; i < blocks; i++ {
+		for j := 0; j < unit; j++ {
+			sequence = append(sequence, start+i)
+		}
+	}
+	return GenomeOf[int]{Sequence: sequence}
+}
+
+// wholeBlocks reports whether sequence is made of whole blocks of unit identical genes
+func wholeBlocks(sequence Sequence[int], unit int) bool {
+	for i := 0; i < len(sequence); i += unit {
+		for j := i; j < i+unit; j++ {
+			if j >= len(sequence) || sequence[j] != sequence[i] {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func TestCutAndSpliceCrossover(t *testing.T) {
+	t.Parallel()
+	bounds := LengthBounds{Min: 3, Max: 24, Unit: 3}
+	crossover := CutAndSpliceCrossover[int](bounds)
+	random := rand.New(rand.NewSource(3))
+	lengths := make(map[int]bool)
+	for i := 0; i < 500; i++ {
+		gene := blockGenome(0, 1+random.Intn(8), bounds.Unit)
+		spouse := blockGenome(100, 1+random.Intn(8), bounds.Unit)
+		children, err := crossover(gene, spouse, random)
+		if err != nil {
+			t.Fatal("Crossover errored unexpectedly. Got:", err)
+		}
+		total := 0
+		for _, child := range children {
+			if !bounds.Contains(len(child.Sequence)) || !wholeBlocks(child.Sequence, bounds.Unit) {
+				t.Fatal("Crossover produced an invalid child.", "Parents:", gene.Sequence, spouse.Sequence, "Got:", child.Sequence)
+			}
+			total += len(child.Sequence)
+			lengths[len(child.Sequence)] = true
+		}
+		if total != len(gene.Sequence)+len(spouse.Sequence) {
+			t.Fatal("Crossover lost or duplicated genes.", "Parents:", gene.Sequence, spouse.Sequence, "Got:", children)
+		}
+	}
+	if len(lengths) < 8 {
+		t.Error("Crossover did not vary the length of children.", "Got lengths:", lengths)
+	}
+	if _, err := crossover(blockGenome(0, 9, 3), blockGenome(0, 1, 3), random); err == nil {
+		t.Error("Crossover accepted a parent above the maximum length")
+	}
+	if _, err := crossover(GenomeOf[int]{Sequence: Sequence[int]{0, 0, 0, 0}}, blockGenome(0, 1, 3), random); err == nil {
+		t.Error("Crossover accepted a parent that is not a whole number of blocks")
+	}
+}
+
+func TestIndelMutation(t *testing.T) {
+	t.Parallel()
+	bounds := LengthBounds{Min: 2, Max: 6, Unit: 2}
+	generate := func(length int, random *rand.Rand) (Sequence[int], error) {
+		return Sequence[int]{-1, -1}, nil
+	}
+	mutate := IndelMutation(bounds, generate)
+	random := rand.New(rand.NewSource(3))
+	grew, shrank := false, false
+	for i := 0; i < 200; i++ {
+		gene := blockGenome(0, 1+random.Intn(3), bounds.Unit)
+		mutated, err := mutate(gene, random)
+		if err != nil {
+			t.Fatal("Mutation errored unexpectedly. Got:", err)
+		}
+		if !bounds.Contains(len(mutated.Sequence)) || !wholeBlocks(mutated.Sequence, bounds.Unit) {
+			t.Fatal("Mutation produced an invalid genome.", "Parent:", gene.Sequence, "Got:", mutated.Sequence)
+		}
+		switch len(mutated.Sequence) - len(gene.Sequence) {
+		case bounds.Unit:
+			grew = true
+		case -bounds.Unit:
+			shrank = true
+		default:
+			t.Fatal("Mutation did not change the length by one block.", "Parent:", gene.Sequence, "Got:", mutated.Sequence)
+		}
+	}
+	if !grew || !shrank {
+		t.Error("Mutation did not both grow and shrink genomes.", "Grew:", grew, "Shrank:", shrank)
+	}
+
+	fixed := IndelMutation(LengthBounds{Min: 4, Max: 4, Unit: 2}, generate)
+	gene := blockGenome(0, 2, 2)
+	if mutated, err := fixed(gene, random); err != nil || len(mutated.Sequence) != 4 {
+		t.Error("Mutation changed a fixed length genome.", "Expected:", gene.Sequence, "Got:", mutated.Sequence, err)
+	}
+}
+
+func TestSetParsimony(t *testing.T) {
+	t.Parallel()
+	var genA = NewGeneticAlgorithm()
+	genA.SetSeed(3)
+	genA.SetOutputFunc(func(a ...interface{}) {})
+	genA.SetFitnessCache(NewFitnessCache(10))
+	genA.SetParsimony(0.5)
+	gene := Genome{Sequence: Bitstring{"1", "1", "0", "1"}}
+
+	tests := []struct {
+		objective Objective
+		expected  float64
+	}{
+		{Maximise, 1},
+		{Minimise, 5},
+	}
+	for _, test := range tests {
+		genA.SetObjective(test.objective)
+		for i := 0; i < 2; i++ {
+			if got := genA.EvaluatePopulation(Population{gene})[0]; got != test.expected {
+				t.Error("Incorrect penalised fitness.", "Objective:", test.objective, "Expected:", test.expected, "Got:", got)
+			}
+		}
+	}
+	if got, _ := genA.FitnessCache.Get(gene); got != 3 {
+		t.Error("Cache stored penalised fitness.", "Expected:", 3, "Got:", got)
+	}
+}
+
+func TestVariableLengthRuleGA(t *testing.T) {
+	t.Parallel()
+	conditionLength := 5
+	outputLength := 1
+	ruleLength := conditionLength + outputLength
+
+	file, err := os.Open("data/data1.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	var inputRules RuleBase
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 || len(fields[0]) != conditionLength {
+			continue
+		}
+		inputRules = append(inputRules, Rule{Condition: strings.Split(fields[0], ""), Output: fields[1]})
+	}
+
+	bounds := LengthBounds{Min: ruleLength, Max: 32 * ruleLength, Unit: ruleLength}
+	generateRule := AlphabetGenerateCandidate(RuleAlphabets(1, conditionLength, outputLength))
+	var geneticAlgorithm = NewVariableLengthGeneticAlgorithm(bounds, generateRule)
+	geneticAlgorithm.SetSeed(3)
+	geneticAlgorithm.SetOutputFunc(func(a ...interface{}) {})
+	geneticAlgorithm.SetParsimony(0.01)
+	geneticAlgorithm.SetFitnessErrFunc(func(gene Genome) (float64, error) {
+		rules, err := DefaultDecodeRulesFunc(gene.Sequence, conditionLength, ruleLength)
+		if err != nil {
+			return 0, err
+		}
+		fitness := 0
+		for _, input := range inputRules {
+			for _, rule := range rules {
+				if matches, _ := DefaultRulesMatchFunc(input, rule); matches {
+					fitness++
+					break
+				}
+			}
+		}
+		return float64(fitness), nil
+	})
+	indel := IndelMutation(bounds, generateRule)
+	geneticAlgorithm.SetMutateErrFunc(func(gene Genome, random *rand.Rand) (Genome, error) {
+		if random.Intn(4) == 0 {
+			return indel(gene, random)
+		}
+		alphabets := RuleAlphabets(len(gene.Sequence)/ruleLength, conditionLength, outputLength)
+		return UniformAlphabetMutation(alphabets, 0)(gene, random)
+	})
+
+	config := NewRunConfig(40, ruleLength, 150)
+	config.EliteCount = 2
+	if err := geneticAlgorithm.RunWithConfig(config); err != nil {
+		t.Fatal("GA errored unexpectedly. Got:", err)
+	}
+	best := geneticAlgorithm.BestCandidate
+	rules, err := DefaultDecodeRulesFunc(best.Sequence, conditionLength, ruleLength)
+	if err != nil {
+		t.Fatal("Best candidate did not decode. Got:", err)
+	}
+	expectedFitness := 28.0
+	if gotFitness := geneticAlgorithm.Fitness(best); gotFitness < expectedFitness {
+		t.Error("GA did not produce a suitable candidate.", "Expected at least:", expectedFitness, "Got:", gotFitness)
+	} else {
+		t.Log("GA produced a suitable candidate.", "Expected at least:", expectedFitness, "Got:", gotFitness, "Rules:", len(rules))
+	}
+	if len(rules) >= 32 {
+		t.Error("Parsimony did not shrink the rule base.", "Expected fewer than:", 32, "Got:", len(rules))
+	}
+}